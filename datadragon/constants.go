@@ -3,9 +3,10 @@ package datadragon
 type dataDragonURL string
 
 const (
-	dataDragonBaseURL        dataDragonURL = "ddragon.leagueoflegends.com"
-	dataDragonDataURLFormat                = dataDragonBaseURL + "/cdn/%s/data/%s"
-	dataDragonImageURLFormat               = dataDragonBaseURL + "/cdn/%s/img"
+	dataDragonBaseURL             dataDragonURL = "ddragon.leagueoflegends.com"
+	dataDragonDataURLFormat                     = dataDragonBaseURL + "/cdn/%s/data/%s"
+	dataDragonImageURLFormat                    = dataDragonBaseURL + "/cdn/%s/img"
+	dataDragonUnversionedImageURL               = dataDragonBaseURL + "/cdn/img"
 )
 
 type languageCode string
@@ -5,6 +5,7 @@ package datadragon
 import (
 	"encoding/json"
 	"fmt"
+	"image"
 	"net/http"
 	"strconv"
 	"strings"
@@ -46,12 +47,15 @@ var (
 
 // Client provides access to all data provided by the Data Dragon service
 type Client struct {
-	logger             log.FieldLogger
+	logger log.FieldLogger
+	// Version of the data. Use SetVersion to change it while the client is in use.
 	Version            string
 	Language           languageCode
 	client             internal.Doer
 	championsMu        sync.RWMutex
 	championsById      map[string]ChampionDataExtended
+	championIDsByKey   map[string]string
+	championIDsByName  map[string]string
 	getChampionsToggle uint32
 	profileIconsMu     sync.RWMutex
 	profileIcons       []ProfileIcon
@@ -61,24 +65,55 @@ type Client struct {
 	masteries          []Mastery
 	runesMu            sync.RWMutex
 	runes              []Item
+	runeTreesMu        sync.RWMutex
+	runeTrees          []RuneTree
+	imagesMu           sync.RWMutex
+	images             map[string]image.Image
 	summonersMu        sync.RWMutex
 	summoners          []SummonerSpell
+	tftOnce            sync.Once
+	tft                *TFTClient
+	versionMu          sync.RWMutex
+	pinned             bool
+	subscribersMu      sync.Mutex
+	subscribers        map[int]func(VersionChange)
+	nextSubscriber     int
+	root               *Client
+	languagesMu        sync.Mutex
+	languages          map[languageCode]*Client
 }
 
-// NewClient returns a new client for the Data Dragon service.
+// NewClient returns a new client for the Data Dragon service. The version and language are those of the realm of the
+// region. If the realm can not be requested the latest version and en_US are used, or version 9.10.1 if the
+// versions can not be requested either. A warning is logged in that case, the chosen version is returned by
+// CurrentVersion.
 func NewClient(client internal.Doer, region api.Region, logger log.FieldLogger) *Client {
-	c := &Client{
-		client:        client,
-		logger:        logger.WithField("client", "data dragon"),
-		championsById: map[string]ChampionDataExtended{},
-	}
+	c := newClient(client, logger.WithField("client", "data dragon"))
 	if err := c.init(regionToRealmRegion[region]); err != nil {
-		c.Version = fallbackVersion
 		c.Language = fallbackLanguage
+		latest, latestErr := c.GetLatestVersion()
+		if latestErr != nil {
+			// without any version information the client would not work at all, so an old version is used
+			latest = fallbackVersion
+		}
+		c.Version = latest
+		c.logger.WithError(err).Warnf(
+			"could not get the version of the region, using version %s and language %s instead", c.Version, c.Language,
+		)
 	}
 	return c
 }
 
+func newClient(client internal.Doer, logger log.FieldLogger) *Client {
+	return &Client{
+		client:            client,
+		logger:            logger,
+		championsById:     map[string]ChampionDataExtended{},
+		championIDsByKey:  map[string]string{},
+		championIDsByName: map[string]string{},
+	}
+}
+
 func (c *Client) init(region string) error {
 	var res struct {
 		Version  string `json:"v"`
@@ -103,15 +138,20 @@ func (c *Client) init(region string) error {
 func (c *Client) GetChampions() ([]ChampionData, error) {
 	unlock, toggle := internal.RWLockToggle(&c.championsMu)
 	defer unlock()
-	if atomic.CompareAndSwapUint32(&c.getChampionsToggle, 0, 1) {
+	if atomic.LoadUint32(&c.getChampionsToggle) == 0 {
 		toggle()
-		var champions map[string]ChampionData
-		if err := c.getInto("/champion.json", &champions); err != nil {
-			return nil, err
-		}
-		for _, champion := range champions {
-			data := ChampionDataExtended{ChampionData: champion}
-			c.championsById[champion.ID] = data
+		// another caller may have loaded the champions while this one was waiting for the write lock
+		if atomic.LoadUint32(&c.getChampionsToggle) == 0 {
+			var champions map[string]ChampionData
+			if err := c.getInto("/champion.json", &champions); err != nil {
+				return nil, err
+			}
+			for _, champion := range champions {
+				data := ChampionDataExtended{ChampionData: champion}
+				c.championsById[champion.ID] = data
+				c.indexChampion(champion)
+			}
+			atomic.StoreUint32(&c.getChampionsToggle, 1)
 		}
 	}
 	res := make([]ChampionData, 0, len(c.championsById))
@@ -121,7 +161,7 @@ func (c *Client) GetChampions() ([]ChampionData, error) {
 	return res, nil
 }
 
-// GetChampionByID returns information about the champion with the given id
+// GetChampionByID returns information about the champion with the given id, e.g. "MonkeyKing"
 func (c *Client) GetChampionByID(id string) (ChampionDataExtended, error) {
 	unlock, toggle := internal.RWLockToggle(&c.championsMu)
 	defer unlock()
@@ -137,22 +177,59 @@ func (c *Client) GetChampionByID(id string) (ChampionDataExtended, error) {
 			return ChampionDataExtended{}, api.ErrNotFound
 		}
 		c.championsById[id] = champion
+		c.indexChampion(champion.ChampionData)
 	}
 	return champion, nil
 }
 
-// GetChampion returns information about the champion with the given name
+// GetChampionByKey returns information about the champion with the given numeric key, e.g. 62 for Wukong. The
+// champion IDs used by the Riot API are keys.
+func (c *Client) GetChampionByKey(key int) (ChampionDataExtended, error) {
+	return c.getChampionBy(
+		func() (string, bool) {
+			id, ok := c.championIDsByKey[strconv.Itoa(key)]
+			return id, ok
+		},
+	)
+}
+
+// GetChampion returns information about the champion with the given name. The name is compared case-insensitively.
 func (c *Client) GetChampion(name string) (ChampionDataExtended, error) {
-	champions, err := c.GetChampions()
-	if err != nil {
+	return c.getChampionBy(
+		func() (string, bool) {
+			id, ok := c.championIDsByName[strings.ToLower(name)]
+			return id, ok
+		},
+	)
+}
+
+// getChampionBy loads all champions and returns the champion with the ID returned by the lookup. The lookup is
+// called after the champions were loaded while holding the read lock of the champions, so it can read the indexes.
+func (c *Client) getChampionBy(lookup func() (string, bool)) (ChampionDataExtended, error) {
+	if _, err := c.GetChampions(); err != nil {
 		return ChampionDataExtended{}, err
 	}
-	for _, champion := range champions {
-		if champion.Name == name {
-			return c.GetChampionByID(champion.ID)
-		}
+	c.championsMu.RLock()
+	id, ok := lookup()
+	c.championsMu.RUnlock()
+	if !ok {
+		return ChampionDataExtended{}, api.ErrNotFound
+	}
+	return c.GetChampionByID(id)
+}
+
+// indexChampion adds the champion to the key and name indexes. The caller has to hold the write lock.
+func (c *Client) indexChampion(champion ChampionData) {
+	if c.championIDsByKey == nil {
+		c.championIDsByKey = map[string]string{}
+		c.championIDsByName = map[string]string{}
+	}
+	if champion.Key != "" {
+		c.championIDsByKey[champion.Key] = champion.ID
+	}
+	if champion.Name != "" {
+		c.championIDsByName[strings.ToLower(champion.Name)] = champion.ID
 	}
-	return ChampionDataExtended{}, api.ErrNotFound
 }
 
 // GetProfileIcons returns all existing profile icons
@@ -226,6 +303,8 @@ func (c *Client) GetItem(id string) (Item, error) {
 
 // GetMasteries returns all existing masteries. Masteries were removed in patch 7.23.1. If any version higher than that
 // is specified the last available version will be used instead.
+//
+// Deprecated: masteries were replaced by Runes Reforged in patch 8.1, use GetRuneTrees instead.
 func (c *Client) GetMasteries() ([]Mastery, error) {
 	unlock, toggle := internal.RWLockToggle(&c.masteriesMu)
 	defer unlock()
@@ -246,6 +325,8 @@ func (c *Client) GetMasteries() ([]Mastery, error) {
 }
 
 // GetMastery returns information about the mastery with the given id
+//
+// Deprecated: masteries were replaced by Runes Reforged in patch 8.1, use GetReforgedRune instead.
 func (c *Client) GetMastery(id int) (Mastery, error) {
 	masteries, err := c.GetMasteries()
 	if err != nil {
@@ -261,6 +342,8 @@ func (c *Client) GetMastery(id int) (Mastery, error) {
 
 // GetRunes returns all existing runes. Runes were removed in patch 7.23.1. If any version higher than that
 // is specified the last available version will be used instead.
+//
+// Deprecated: the legacy runes were replaced by Runes Reforged in patch 8.1, use GetRuneTrees instead.
 func (c *Client) GetRunes() ([]Item, error) {
 	unlock, toggle := internal.RWLockToggle(&c.runesMu)
 	defer unlock()
@@ -282,6 +365,8 @@ func (c *Client) GetRunes() ([]Item, error) {
 }
 
 // GetRune returns information about the rune with the given id
+//
+// Deprecated: the legacy runes were replaced by Runes Reforged in patch 8.1, use GetReforgedRune instead.
 func (c *Client) GetRune(id string) (Item, error) {
 	runes, err := c.GetRunes()
 	if err != nil {
@@ -329,27 +414,50 @@ func (c *Client) GetSummonerSpell(id string) (SummonerSpell, error) {
 	return SummonerSpell{}, api.ErrNotFound
 }
 
-// ClearCaches resets all caches of the data dragon client
+// TFT returns the client for the Teamfight Tactics data, which uses the version, language and caches of this client
+func (c *Client) TFT() *TFTClient {
+	c.tftOnce.Do(
+		func() {
+			c.tft = NewTFTClient(c)
+		},
+	)
+	return c.tft
+}
+
+// ClearCaches resets all caches of the data dragon client, including the caches of the Teamfight Tactics data and
+// of the clients returned by WithLanguage
 func (c *Client) ClearCaches() {
-	c.championsMu.Lock()
+	c.swapCaches(func() {})
+	for _, view := range c.languageViews() {
+		view.ClearCaches()
+	}
+}
+
+// swapCaches calls swap and resets all caches, including the caches of the Teamfight Tactics data, while holding the
+// locks of all caches. Requests filling a cache hold its lock, so no data requested before swap is cached afterwards.
+func (c *Client) swapCaches(swap func()) {
+	unlock := lockAll(
+		append(
+			[]*sync.RWMutex{
+				&c.championsMu, &c.profileIconsMu, &c.itemsMu, &c.masteriesMu, &c.runesMu, &c.runeTreesMu,
+				&c.summonersMu, &c.imagesMu,
+			}, c.TFT().mutexes()...,
+		),
+	)
+	defer unlock()
+	swap()
 	c.championsById = map[string]ChampionDataExtended{}
+	c.championIDsByKey = map[string]string{}
+	c.championIDsByName = map[string]string{}
 	atomic.StoreUint32(&c.getChampionsToggle, 0)
-	c.championsMu.Unlock()
-	c.masteriesMu.Lock()
 	c.masteries = []Mastery{}
-	c.masteriesMu.Unlock()
-	c.profileIconsMu.Lock()
 	c.profileIcons = []ProfileIcon{}
-	c.profileIconsMu.Unlock()
-	c.itemsMu.Lock()
 	c.items = []Item{}
-	c.itemsMu.Unlock()
-	c.summonersMu.Lock()
 	c.summoners = []SummonerSpell{}
-	c.summonersMu.Unlock()
-	c.runesMu.Lock()
 	c.runes = []Item{}
-	c.runesMu.Unlock()
+	c.runeTrees = nil
+	c.images = nil
+	c.TFT().reset()
 }
 
 func (c *Client) getInto(endpoint string, target interface{}) error {
@@ -371,6 +479,10 @@ func (c *Client) doRequest(format dataDragonURL, endpoint string) (*http.Respons
 	if err != nil {
 		return nil, err
 	}
+	return c.do(request)
+}
+
+func (c *Client) do(request *http.Request) (*http.Response, error) {
 	response, err := c.client.Do(request)
 	if err != nil {
 		return nil, err
@@ -390,12 +502,10 @@ func (c *Client) doRequest(format dataDragonURL, endpoint string) (*http.Respons
 }
 
 func (c *Client) newRequest(format dataDragonURL, endpoint string) (*http.Request, error) {
-	var version string
-	if (strings.Contains(endpoint, "rune") || strings.Contains(endpoint, "mastery")) &&
-		versionGreaterThan(c.Version, latestRuneAndMasteryVersion) {
+	version := c.CurrentVersion()
+	if (endpoint == "/rune.json" || endpoint == "/mastery.json") &&
+		versionGreaterThan(version, latestRuneAndMasteryVersion) {
 		version = latestRuneAndMasteryVersion
-	} else {
-		version = c.Version
 	}
 	var url string
 	switch format {
@@ -414,23 +524,11 @@ func (c *Client) newRequest(format dataDragonURL, endpoint string) (*http.Reques
 	return request, nil
 }
 
+// versionGreaterThan returns whether both versions are valid and v1 is greater than v2
 func versionGreaterThan(v1, v2 string) bool {
-	v1Split := strings.Split(v1, ".")
-	v2Split := strings.Split(v2, ".")
-	for i := 0; i < len(v1Split) && i < len(v2Split); i++ {
-		int1, err := strconv.Atoi(v1Split[i])
-		if err != nil {
-			return false
-		}
-		int2, err := strconv.Atoi(v2Split[i])
-		if err != nil {
-			return false
-		}
-		if int1 > int2 {
-			return true
-		}
-	}
-	return false
+	_, ok1 := parseVersion(v1)
+	_, ok2 := parseVersion(v2)
+	return ok1 && ok2 && CompareVersions(v1, v2) > 0
 }
 
 type dataDragonResponse struct {
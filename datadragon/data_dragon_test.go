@@ -3,6 +3,7 @@ package datadragon
 import (
 	"fmt"
 	"net/http"
+	"sync"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
@@ -151,6 +152,131 @@ func TestClient_GetChampion(t *testing.T) {
 	}
 }
 
+func TestClient_GetChampionByKey(t *testing.T) {
+	t.Parallel()
+	champions := map[string]ChampionData{
+		"MonkeyKing": {ID: "MonkeyKing", Key: "62", Name: "Wukong"},
+	}
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		key     int
+		want    ChampionDataExtended
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: mock.NewPathJSONMockDoer(
+				[]mock.PathJSONResponse{
+					{
+						PathSuffix: "/champion.json",
+						Object:     dataDragonResponse{Data: champions},
+						Code:       200,
+					},
+					{
+						PathSuffix: "/champion/MonkeyKing.json",
+						Object: dataDragonResponse{
+							Data: map[string]ChampionDataExtended{
+								"MonkeyKing": {ChampionData: champions["MonkeyKing"], Lore: "lore"},
+							},
+						},
+						Code: 200,
+					},
+				},
+			),
+			key:  62,
+			want: ChampionDataExtended{ChampionData: champions["MonkeyKing"], Lore: "lore"},
+		},
+		{
+			name:    "not found",
+			doer:    dataDragonResponseDoer(champions),
+			key:     1,
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+				got, err := c.GetChampionByKey(tt.key)
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+}
+
+func TestClient_GetChampions_retryAfterError(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	fail := true
+	champions := map[string]ChampionData{"MonkeyKing": {ID: "MonkeyKing", Key: "62", Name: "Wukong"}}
+	c := newClient(
+		&mock.Doer{
+			Custom: func(r *http.Request) (*http.Response, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				if fail {
+					fail = false
+					return mock.NewStatusMockDoer(http.StatusServiceUnavailable).Do(r)
+				}
+				return dataDragonResponseDoer(champions).Do(r)
+			},
+		}, log.StandardLogger(),
+	)
+	_, err := c.GetChampionByKey(62)
+	assert.Equal(t, api.ErrServiceUnavailable, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := c.GetChampions()
+			assert.Nil(t, err)
+			assert.Len(t, got, 1)
+		}()
+	}
+	wg.Wait()
+	got, err := c.GetChampionByKey(62)
+	require.Nil(t, err)
+	assert.Equal(t, "MonkeyKing", got.ID)
+}
+
+func TestClient_GetChampion_CaseInsensitive(t *testing.T) {
+	t.Parallel()
+	c := NewClient(
+		dataDragonResponseDoer(map[string]ChampionData{"MonkeyKing": {ID: "MonkeyKing", Key: "62", Name: "Wukong"}}),
+		api.RegionEuropeWest, log.StandardLogger(),
+	)
+	got, err := c.GetChampion("wUKONG")
+	require.Nil(t, err)
+	assert.Equal(t, "MonkeyKing", got.ID)
+	got, err = c.GetChampionByKey(62)
+	require.Nil(t, err)
+	assert.Equal(t, "Wukong", got.Name)
+	c.ClearCaches()
+	assert.Empty(t, c.championIDsByKey)
+	assert.Empty(t, c.championIDsByName)
+	got, err = c.GetChampionByKey(62)
+	require.Nil(t, err)
+	assert.Equal(t, "MonkeyKing", got.ID)
+
+	c = &Client{
+		client:        dataDragonResponseDoer(map[string]ChampionData{"MonkeyKing": {ID: "MonkeyKing", Key: "62"}}),
+		logger:        log.StandardLogger(),
+		championsById: map[string]ChampionDataExtended{},
+	}
+	got, err = c.GetChampionByKey(62)
+	require.Nil(t, err)
+	assert.Equal(t, "MonkeyKing", got.ID)
+}
+
 func TestClient_GetProfileIcons(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -793,6 +919,22 @@ func Test_versionGreaterThan(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "second greater in later part",
+			args: args{
+				v1: "7.2.5",
+				v2: "7.10.1",
+			},
+			want: false,
+		},
+		{
+			name: "first greater",
+			args: args{
+				v1: "13.24.1",
+				v2: "7.23.1",
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(
@@ -0,0 +1,147 @@
+package datadragon
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	// register the formats of the Data Dragon images for image.Decode
+	_ "image/jpeg"
+	_ "image/png"
+	"net/http"
+	"strings"
+)
+
+// ImageURL returns the URL of the given image for the version of the client
+func (c *Client) ImageURL(image ImageData) string {
+	return c.versionedImageURL(image.Group, image.Full)
+}
+
+// SpriteURL returns the URL of the sprite sheet containing the given image. The position of the image in the sheet
+// is given by its X, Y, W and H, see CropSprite.
+func (c *Client) SpriteURL(image ImageData) string {
+	return c.versionedImageURL("sprite", image.Sprite)
+}
+
+// ChampionSquareURL returns the URL of the square icon of the champion
+func (c *Client) ChampionSquareURL(champion ChampionData) string {
+	return c.versionedImageURL("champion", champion.Image.Full)
+}
+
+// ChampionSplashURL returns the URL of the splash art of the skin of the champion with the given id, e.g. MonkeyKing
+func (c *Client) ChampionSplashURL(championID string, skin SkinData) string {
+	return unversionedImageURL(fmt.Sprintf("champion/splash/%s_%d.jpg", championID, skin.Num))
+}
+
+// ChampionLoadingURL returns the URL of the loading screen art of the skin of the champion with the given id
+func (c *Client) ChampionLoadingURL(championID string, skin SkinData) string {
+	return unversionedImageURL(fmt.Sprintf("champion/loading/%s_%d.jpg", championID, skin.Num))
+}
+
+// SpellImageURL returns the URL of the icon of the champion spell
+func (c *Client) SpellImageURL(spell SpellData) string {
+	return c.versionedImageURL("spell", spell.Image.Full)
+}
+
+// PassiveImageURL returns the URL of the icon of the champion passive
+func (c *Client) PassiveImageURL(passive PassiveData) string {
+	return c.versionedImageURL("passive", passive.Image.Full)
+}
+
+// ItemImageURL returns the URL of the icon of the item
+func (c *Client) ItemImageURL(item Item) string {
+	full := item.Image.Full
+	if full == "" {
+		full = item.ID + ".png"
+	}
+	return c.versionedImageURL("item", full)
+}
+
+// ProfileIconURL returns the URL of the profile icon
+func (c *Client) ProfileIconURL(icon ProfileIcon) string {
+	full := icon.Image.Full
+	if full == "" {
+		full = fmt.Sprintf("%d.png", icon.ID)
+	}
+	return c.versionedImageURL("profileicon", full)
+}
+
+// SummonerSpellImageURL returns the URL of the icon of the summoner spell
+func (c *Client) SummonerSpellImageURL(spell SummonerSpell) string {
+	return c.versionedImageURL("spell", spell.Image.Full)
+}
+
+// RuneIconURL returns the URL of the icon of the rune. Stat shards have no icon in the Data Dragon data.
+func (c *Client) RuneIconURL(r Rune) string {
+	return unversionedImageURL(r.Icon)
+}
+
+// RuneTreeIconURL returns the URL of the icon of the rune tree
+func (c *Client) RuneTreeIconURL(tree RuneTree) string {
+	return unversionedImageURL(tree.Icon)
+}
+
+// GetImage downloads and decodes the PNG or JPEG image at the given URL. Images are cached by their URL until the
+// caches of the client are cleared.
+func (c *Client) GetImage(url string) (image.Image, error) {
+	c.imagesMu.RLock()
+	img, ok := c.images[url]
+	c.imagesMu.RUnlock()
+	if ok {
+		return img, nil
+	}
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.Body == nil {
+		return nil, fmt.Errorf("no response body")
+	}
+	defer response.Body.Close()
+	img, _, err = image.Decode(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.imagesMu.Lock()
+	defer c.imagesMu.Unlock()
+	if c.images == nil {
+		c.images = map[string]image.Image{}
+	}
+	c.images[url] = img
+	return img, nil
+}
+
+// GetSprite downloads the sprite sheet containing the given image and returns the image cropped from it
+func (c *Client) GetSprite(image ImageData) (image.Image, error) {
+	sprite, err := c.GetImage(c.SpriteURL(image))
+	if err != nil {
+		return nil, err
+	}
+	return CropSprite(sprite, image), nil
+}
+
+// CropSprite returns the part of the sprite sheet described by the X, Y, W and H of the image. The result shares
+// its pixels with the sprite sheet if the sheet supports it, like all images returned by image.Decode.
+func CropSprite(sprite image.Image, data ImageData) image.Image {
+	bounds := sprite.Bounds()
+	rect := image.Rect(data.X, data.Y, data.X+data.W, data.Y+data.H).Add(bounds.Min).Intersect(bounds)
+	if sub, ok := sprite.(interface {
+		SubImage(image.Rectangle) image.Image
+	}); ok {
+		return sub.SubImage(rect)
+	}
+	res := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	draw.Draw(res, res.Bounds(), sprite, rect.Min, draw.Src)
+	return res
+}
+
+func (c *Client) versionedImageURL(group, file string) string {
+	return "https://" + fmt.Sprintf(string(dataDragonImageURLFormat), c.CurrentVersion()) + "/" + group + "/" + file
+}
+
+func unversionedImageURL(path string) string {
+	return "https://" + string(dataDragonUnversionedImageURL) + "/" + strings.TrimPrefix(path, "/")
+}
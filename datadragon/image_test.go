@@ -0,0 +1,168 @@
+package datadragon
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestClient_imageURLs(t *testing.T) {
+	t.Parallel()
+	c := &Client{Version: "13.24.1"}
+	const versioned = "https://ddragon.leagueoflegends.com/cdn/13.24.1/img/"
+	const unversioned = "https://ddragon.leagueoflegends.com/cdn/img/"
+	skin := SkinData{Num: 7}
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{
+			name: "image",
+			got:  c.ImageURL(ImageData{Group: "champion", Full: "Ahri.png"}),
+			want: versioned + "champion/Ahri.png",
+		},
+		{
+			name: "sprite",
+			got:  c.SpriteURL(ImageData{Sprite: "champion0.png"}),
+			want: versioned + "sprite/champion0.png",
+		},
+		{
+			name: "champion square",
+			got:  c.ChampionSquareURL(ChampionData{Image: ImageData{Full: "MonkeyKing.png"}}),
+			want: versioned + "champion/MonkeyKing.png",
+		},
+		{
+			name: "splash",
+			got:  c.ChampionSplashURL("MonkeyKing", skin),
+			want: unversioned + "champion/splash/MonkeyKing_7.jpg",
+		},
+		{
+			name: "loading",
+			got:  c.ChampionLoadingURL("MonkeyKing", skin),
+			want: unversioned + "champion/loading/MonkeyKing_7.jpg",
+		},
+		{
+			name: "spell",
+			got:  c.SpellImageURL(SpellData{Image: ImageData{Full: "AhriQ.png"}}),
+			want: versioned + "spell/AhriQ.png",
+		},
+		{
+			name: "passive",
+			got:  c.PassiveImageURL(PassiveData{Image: ImageData{Full: "Ahri_SoulEater2.png"}}),
+			want: versioned + "passive/Ahri_SoulEater2.png",
+		},
+		{
+			name: "item",
+			got:  c.ItemImageURL(Item{ID: "1001"}),
+			want: versioned + "item/1001.png",
+		},
+		{
+			name: "profile icon",
+			got:  c.ProfileIconURL(ProfileIcon{ID: 588}),
+			want: versioned + "profileicon/588.png",
+		},
+		{
+			name: "summoner spell",
+			got:  c.SummonerSpellImageURL(SummonerSpell{Image: ImageData{Full: "SummonerFlash.png"}}),
+			want: versioned + "spell/SummonerFlash.png",
+		},
+		{
+			name: "rune",
+			got:  c.RuneIconURL(Rune{Icon: "perk-images/Styles/Precision/PressTheAttack/PressTheAttack.png"}),
+			want: unversioned + "perk-images/Styles/Precision/PressTheAttack/PressTheAttack.png",
+		},
+		{
+			name: "rune tree",
+			got:  c.RuneTreeIconURL(RuneTree{Icon: "perk-images/Styles/7201_Precision.png"}),
+			want: unversioned + "perk-images/Styles/7201_Precision.png",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.got)
+			},
+		)
+	}
+}
+
+// testSprite returns a 4x2 sprite sheet whose left half is red and right half is blue
+func testSprite() *image.RGBA {
+	sprite := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for x := 0; x < 4; x++ {
+		for y := 0; y < 2; y++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			sprite.Set(x, y, c)
+		}
+	}
+	return sprite
+}
+
+// opaqueImage hides the SubImage method of the wrapped image
+type opaqueImage struct {
+	image.Image
+}
+
+func TestCropSprite(t *testing.T) {
+	t.Parallel()
+	data := ImageData{X: 2, Y: 0, W: 2, H: 2}
+	for _, sprite := range []image.Image{testSprite(), opaqueImage{testSprite()}} {
+		got := CropSprite(sprite, data)
+		assert.Equal(t, 2, got.Bounds().Dx())
+		assert.Equal(t, 2, got.Bounds().Dy())
+		r, g, b, _ := got.At(got.Bounds().Min.X, got.Bounds().Min.Y).RGBA()
+		assert.Equal(t, [3]uint32{0, 0, 0xffff}, [3]uint32{r, g, b})
+	}
+}
+
+func TestClient_GetImage(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	require.Nil(t, png.Encode(&buf, testSprite()))
+	requests := 0
+	c := &Client{
+		Version: "13.24.1",
+		client: &mock.Doer{
+			Custom: func(r *http.Request) (*http.Response, error) {
+				requests++
+				switch r.URL.Path {
+				case "/cdn/13.24.1/img/sprite/spell0.png":
+					return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader(buf.Bytes()))}, nil
+				case "/cdn/13.24.1/img/sprite/invalid.png":
+					return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte("invalid")))}, nil
+				}
+				return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+			},
+		},
+	}
+	got, err := c.GetSprite(ImageData{Sprite: "spell0.png", X: 0, Y: 0, W: 2, H: 1})
+	require.Nil(t, err)
+	assert.Equal(t, image.Rect(0, 0, 2, 1), got.Bounds())
+	_, err = c.GetImage(c.SpriteURL(ImageData{Sprite: "spell0.png"}))
+	require.Nil(t, err)
+	assert.Equal(t, 1, requests)
+
+	c.ClearCaches()
+	_, err = c.GetImage(c.SpriteURL(ImageData{Sprite: "spell0.png"}))
+	require.Nil(t, err)
+	assert.Equal(t, 2, requests)
+
+	_, err = c.GetSprite(ImageData{Sprite: "unknown.png"})
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.GetImage(c.SpriteURL(ImageData{Sprite: "invalid.png"}))
+	assert.Equal(t, image.ErrFormat, err)
+}
@@ -0,0 +1,238 @@
+package datadragon
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// ItemGraph connects the items of a version to the components they are built from and the items they build into
+type ItemGraph struct {
+	items map[string]Item
+}
+
+// ItemTree is an item with the trees of the components it is built from
+type ItemTree struct {
+	Item       Item
+	Components []ItemTree
+}
+
+// ItemFilter selects items of an ItemGraph. The zero value selects all items.
+type ItemFilter struct {
+	// Tags the items must all have, e.g. Boots. Tags are compared case-insensitively.
+	Tags []string
+	// ID of the map the items must be available on, e.g. 11 for Summoner's Rift. 0 selects items of all maps.
+	MapID int
+	// Skip items which can not be bought in the shop
+	PurchasableOnly bool
+}
+
+// GetItemGraph returns the build graph of all items
+func (c *Client) GetItemGraph() (*ItemGraph, error) {
+	items, err := c.GetItems()
+	if err != nil {
+		return nil, err
+	}
+	return NewItemGraph(items), nil
+}
+
+// NewItemGraph returns the build graph of the given items
+func NewItemGraph(items []Item) *ItemGraph {
+	g := &ItemGraph{items: make(map[string]Item, len(items))}
+	for _, item := range items {
+		g.items[item.ID] = item
+	}
+	return g
+}
+
+// Item returns the item with the given id
+func (g *ItemGraph) Item(id string) (Item, bool) {
+	item, ok := g.items[id]
+	return item, ok
+}
+
+// Items returns all items sorted by their numeric id
+func (g *ItemGraph) Items() []Item {
+	return g.Filter(ItemFilter{})
+}
+
+// Filter returns the items selected by the filter sorted by their numeric id
+func (g *ItemGraph) Filter(filter ItemFilter) []Item {
+	var res []Item
+	for _, item := range g.items {
+		if filter.matches(item) {
+			res = append(res, item)
+		}
+	}
+	sort.Slice(
+		res, func(i, j int) bool {
+			return itemIDLess(res[i].ID, res[j].ID)
+		},
+	)
+	return res
+}
+
+// Components returns the items the item with the given id is built from. A component used twice is returned twice.
+func (g *ItemGraph) Components(id string) []Item {
+	return g.lookup(g.items[id].From)
+}
+
+// BuildsInto returns the items the item with the given id is a component of
+func (g *ItemGraph) BuildsInto(id string) []Item {
+	return g.lookup(g.items[id].Into)
+}
+
+// Tree returns the item with the given id with the trees of all its components
+func (g *ItemGraph) Tree(id string) (ItemTree, error) {
+	if _, ok := g.items[id]; !ok {
+		return ItemTree{}, api.ErrNotFound
+	}
+	return g.tree(id, map[string]bool{}), nil
+}
+
+func (g *ItemGraph) tree(id string, path map[string]bool) ItemTree {
+	res := ItemTree{Item: g.items[id]}
+	// the data does not contain cycles, but a broken recipe must not lead to an endless recursion
+	path[id] = true
+	defer delete(path, id)
+	for _, component := range res.Item.From {
+		if _, ok := g.items[component]; ok && !path[component] {
+			res.Components = append(res.Components, g.tree(component, path))
+		}
+	}
+	return res
+}
+
+// BuildStats returns the summed stats of the items with the given ids. An id can be given more than once for items
+// which are part of the build more than once.
+func (g *ItemGraph) BuildStats(ids ...string) (ItemStats, error) {
+	items, err := g.build(ids)
+	if err != nil {
+		return ItemStats{}, err
+	}
+	return SumItemStats(items...), nil
+}
+
+// BuildCost returns the total cost of the items with the given ids
+func (g *ItemGraph) BuildCost(ids ...string) (int, error) {
+	items, err := g.build(ids)
+	if err != nil {
+		return 0, err
+	}
+	var res int
+	for _, item := range items {
+		res += item.Gold.Total
+	}
+	return res, nil
+}
+
+func (g *ItemGraph) build(ids []string) ([]Item, error) {
+	items := make([]Item, 0, len(ids))
+	for _, id := range ids {
+		item, ok := g.items[id]
+		if !ok {
+			return nil, api.ErrNotFound
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// lookup returns the items with the given ids, skipping unknown ids like items of other maps
+func (g *ItemGraph) lookup(ids []string) []Item {
+	var res []Item
+	for _, id := range ids {
+		if item, ok := g.items[id]; ok {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// TotalCost returns the gold needed to buy the item with all of its components
+func (t ItemTree) TotalCost() int {
+	return t.Item.Gold.Total
+}
+
+// CombineCost returns the gold needed to combine the components into the item. It is the base cost of the item, as
+// components which are not part of the graph are missing from the tree.
+func (t ItemTree) CombineCost() int {
+	return t.Item.Gold.Base
+}
+
+// BasicComponents returns the items without components the item is built from. The item itself is returned if it
+// has no components.
+func (t ItemTree) BasicComponents() []Item {
+	if len(t.Components) == 0 {
+		return []Item{t.Item}
+	}
+	var res []Item
+	for _, component := range t.Components {
+		res = append(res, component.BasicComponents()...)
+	}
+	return res
+}
+
+// AvailableOn returns whether the item is available on the map with the given id, e.g. 11 for Summoner's Rift
+func (i Item) AvailableOn(mapID int) bool {
+	return i.Maps[strconv.Itoa(mapID)]
+}
+
+// HasTag returns whether the item has the tag. Tags are compared case-insensitively.
+func (i Item) HasTag(tag string) bool {
+	for _, t := range i.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add returns the sum of both stats, field by field. Fields which are not numbers are kept from s.
+func (s ItemStats) Add(other ItemStats) ItemStats {
+	res := reflect.ValueOf(&s).Elem()
+	o := reflect.ValueOf(other)
+	for i := 0; i < res.NumField(); i++ {
+		if res.Field(i).Kind() == reflect.Float64 {
+			res.Field(i).SetFloat(res.Field(i).Float() + o.Field(i).Float())
+		}
+	}
+	return s
+}
+
+// SumItemStats returns the summed stats of the items
+func SumItemStats(items ...Item) ItemStats {
+	var res ItemStats
+	for _, item := range items {
+		res = res.Add(item.Stats)
+	}
+	return res
+}
+
+func (f ItemFilter) matches(item Item) bool {
+	if f.MapID != 0 && !item.AvailableOn(f.MapID) {
+		return false
+	}
+	if f.PurchasableOnly && !item.Gold.Purchasable {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !item.HasTag(tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// itemIDLess returns whether the id a is lower than b, comparing numeric ids by their value
+func itemIDLess(a, b string) bool {
+	x, errA := strconv.Atoi(a)
+	y, errB := strconv.Atoi(b)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return x < y
+}
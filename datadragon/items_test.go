@@ -0,0 +1,188 @@
+package datadragon
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+const testItems = `{
+	"1001": {
+		"name": "Boots",
+		"gold": {"base": 300, "total": 300, "purchasable": true},
+		"into": ["3006", "3158"],
+		"tags": ["Boots"],
+		"maps": {"11": true, "12": true},
+		"stats": {"FlatMovementSpeedMod": 25}
+	},
+	"1042": {
+		"name": "Dagger",
+		"gold": {"base": 300, "total": 300, "purchasable": true},
+		"into": ["3006", "3124"],
+		"tags": ["AttackSpeed"],
+		"maps": {"11": true, "12": true},
+		"stats": {"PercentAttackSpeedMod": 0.12}
+	},
+	"1043": {
+		"name": "Recurve Bow",
+		"gold": {"base": 400, "total": 1000, "purchasable": true},
+		"from": ["1042", "1042"],
+		"into": ["3124"],
+		"tags": ["AttackSpeed"],
+		"maps": {"11": true, "12": true},
+		"stats": {"PercentAttackSpeedMod": 0.25}
+	},
+	"3006": {
+		"name": "Berserker's Greaves",
+		"gold": {"base": 500, "total": 1100, "purchasable": true},
+		"from": ["1001", "1042"],
+		"tags": ["Boots", "AttackSpeed"],
+		"maps": {"11": true, "12": false},
+		"stats": {"FlatMovementSpeedMod": 45, "PercentAttackSpeedMod": 0.35}
+	},
+	"3124": {
+		"name": "Guinsoo's Rageblade",
+		"gold": {"base": 1000, "total": 3000, "purchasable": true},
+		"from": ["1043", "1042", "1037"],
+		"tags": ["AttackSpeed", "OnHit"],
+		"maps": {"11": true, "12": true},
+		"stats": {"PercentAttackSpeedMod": 0.35}
+	},
+	"223006": {
+		"name": "Berserker's Greaves",
+		"gold": {"total": 0, "purchasable": false},
+		"tags": ["Boots"],
+		"maps": {"30": true}
+	}
+}`
+
+func testItemGraph(t *testing.T) *ItemGraph {
+	client := NewClient(dataDragonResponseDoer(json.RawMessage(testItems)), api.RegionEuropeWest, log.StandardLogger())
+	graph, err := client.GetItemGraph()
+	require.Nil(t, err)
+	return graph
+}
+
+func itemIDs(items []Item) []string {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+func TestClient_GetItemGraph(t *testing.T) {
+	t.Parallel()
+	graph := testItemGraph(t)
+	item, ok := graph.Item("3006")
+	require.True(t, ok)
+	assert.Equal(t, "Berserker's Greaves", item.Name)
+	assert.Equal(t, []string{"1001", "1042", "1043", "3006", "3124", "223006"}, itemIDs(graph.Items()))
+	assert.Equal(t, []string{"1001", "1042"}, itemIDs(graph.Components("3006")))
+	assert.Equal(t, []string{"1042", "1042"}, itemIDs(graph.Components("1043")))
+	// 3158 is not part of the data
+	assert.Equal(t, []string{"3006"}, itemIDs(graph.BuildsInto("1001")))
+	assert.Empty(t, graph.Components("unknown"))
+
+	_, err := NewClient(mock.NewStatusMockDoer(http.StatusForbidden), api.RegionEuropeWest, log.StandardLogger()).
+		GetItemGraph()
+	assert.Equal(t, api.ErrForbidden, err)
+}
+
+func TestItemGraph_Tree(t *testing.T) {
+	t.Parallel()
+	graph := testItemGraph(t)
+	tree, err := graph.Tree("3124")
+	require.Nil(t, err)
+	assert.Equal(t, "3124", tree.Item.ID)
+	assert.Equal(t, []string{"1043", "1042"}, itemIDs([]Item{tree.Components[0].Item, tree.Components[1].Item}))
+	assert.Equal(t, 3000, tree.TotalCost())
+	assert.Equal(t, 1000, tree.CombineCost(), "missing components should not be part of the cost")
+	assert.Equal(t, 400, tree.Components[0].CombineCost())
+	assert.Equal(t, []string{"1042", "1042", "1042"}, itemIDs(tree.BasicComponents()))
+
+	tree, err = graph.Tree("1001")
+	require.Nil(t, err)
+	assert.Equal(t, 300, tree.CombineCost())
+	assert.Equal(t, []string{"1001"}, itemIDs(tree.BasicComponents()))
+
+	_, err = graph.Tree("unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+
+	cyclic := NewItemGraph([]Item{{ID: "1", From: []string{"2"}}, {ID: "2", From: []string{"1"}}})
+	tree, err = cyclic.Tree("1")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"2"}, itemIDs(tree.BasicComponents()))
+}
+
+func TestItemGraph_Filter(t *testing.T) {
+	t.Parallel()
+	graph := testItemGraph(t)
+	tests := []struct {
+		name   string
+		filter ItemFilter
+		want   []string
+	}{
+		{
+			name:   "tag",
+			filter: ItemFilter{Tags: []string{"boots"}},
+			want:   []string{"1001", "3006", "223006"},
+		},
+		{
+			name:   "tags",
+			filter: ItemFilter{Tags: []string{"Boots", "AttackSpeed"}},
+			want:   []string{"3006"},
+		},
+		{
+			name:   "map",
+			filter: ItemFilter{Tags: []string{"Boots"}, MapID: 12},
+			want:   []string{"1001"},
+		},
+		{
+			name:   "purchasable",
+			filter: ItemFilter{Tags: []string{"Boots"}, PurchasableOnly: true},
+			want:   []string{"1001", "3006"},
+		},
+		{
+			name:   "none",
+			filter: ItemFilter{MapID: 21},
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, itemIDs(graph.Filter(tt.filter)))
+			},
+		)
+	}
+}
+
+func TestItemGraph_BuildStats(t *testing.T) {
+	t.Parallel()
+	graph := testItemGraph(t)
+	stats, err := graph.BuildStats("3006", "3124", "1042")
+	require.Nil(t, err)
+	assert.Equal(t, 45.0, stats.FlatMovementSpeedMod)
+	assert.InDelta(t, 0.82, stats.PercentAttackSpeedMod, 1e-9)
+	cost, err := graph.BuildCost("3006", "3124", "1042")
+	require.Nil(t, err)
+	assert.Equal(t, 4400, cost)
+
+	_, err = graph.BuildStats("3006", "unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = graph.BuildCost("unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+
+	assert.Equal(
+		t, ItemStats{FlatArmorMod: 30, FlatHPPoolMod: 200},
+		ItemStats{FlatArmorMod: 10}.Add(ItemStats{FlatArmorMod: 20, FlatHPPoolMod: 200}),
+	)
+}
@@ -0,0 +1,39 @@
+package datadragon
+
+// WithLanguage returns a client for the data in the given language. The client shares the connection and version
+// with c but has its own caches, which are filled on first use. The same client is returned for every call with the
+// same language, so the data of each language is only requested once. Changes of the version of c and calls to
+// c.ClearCaches also apply to the returned client.
+func (c *Client) WithLanguage(language languageCode) *Client {
+	root := c
+	if c.root != nil {
+		root = c.root
+	}
+	if language == root.Language {
+		return root
+	}
+	root.languagesMu.Lock()
+	defer root.languagesMu.Unlock()
+	if view, ok := root.languages[language]; ok {
+		return view
+	}
+	view := newClient(root.client, root.logger.WithField("language", language))
+	view.Version = root.CurrentVersion()
+	view.Language = language
+	view.root = root
+	if root.languages == nil {
+		root.languages = map[languageCode]*Client{}
+	}
+	root.languages[language] = view
+	return view
+}
+
+func (c *Client) languageViews() []*Client {
+	c.languagesMu.Lock()
+	defer c.languagesMu.Unlock()
+	views := make([]*Client, 0, len(c.languages))
+	for _, view := range c.languages {
+		views = append(views, view)
+	}
+	return views
+}
@@ -0,0 +1,74 @@
+package datadragon
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+// languageDoer returns a Doer which returns a champion named after the language of the request
+func languageDoer() *mock.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			language := strings.Split(r.URL.Path, "/")[4]
+			return dataDragonResponseDoer(
+				map[string]ChampionData{"Ahri": {ID: "Ahri", Key: "103", Name: "Ahri " + language}},
+			).Do(r)
+		},
+	}
+}
+
+func TestClient_WithLanguage(t *testing.T) {
+	t.Parallel()
+	client := newClient(languageDoer(), log.StandardLogger())
+	client.Version = "13.24.1"
+	client.Language = LanguageCodeUnitedStates
+
+	german := client.WithLanguage(LanguageCodeGermany)
+	assert.Same(t, german, client.WithLanguage(LanguageCodeGermany))
+	assert.Same(t, german, german.WithLanguage(LanguageCodeGermany))
+	assert.Same(t, client, german.WithLanguage(LanguageCodeUnitedStates))
+	assert.Same(t, client, client.WithLanguage(LanguageCodeUnitedStates))
+
+	champion, err := german.GetChampionByKey(103)
+	require.Nil(t, err)
+	assert.Equal(t, "Ahri de_DE", champion.Name)
+	champion, err = client.GetChampionByKey(103)
+	require.Nil(t, err)
+	assert.Equal(t, "Ahri en_US", champion.Name)
+
+	client.SetVersion("14.1.1")
+	assert.Equal(t, "14.1.1", german.CurrentVersion())
+	assert.Empty(t, german.championsById)
+
+	_, err = german.GetChampions()
+	require.Nil(t, err)
+	client.ClearCaches()
+	assert.Empty(t, german.championsById)
+}
+
+func TestClient_WithLanguage_concurrent(t *testing.T) {
+	t.Parallel()
+	client := newClient(languageDoer(), log.StandardLogger())
+	client.Language = LanguageCodeUnitedStates
+	languages := []languageCode{LanguageCodeGermany, LanguageCodeFrance, LanguageCodeKorea}
+	var wg sync.WaitGroup
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func(language languageCode) {
+			defer wg.Done()
+			champion, err := client.WithLanguage(language).GetChampion("Ahri " + string(language))
+			assert.Nil(t, err)
+			assert.Equal(t, "Ahri", champion.ID)
+		}(languages[i%len(languages)])
+	}
+	wg.Wait()
+	assert.Len(t, client.languageViews(), len(languages))
+}
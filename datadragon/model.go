@@ -22,7 +22,7 @@ type ChampionData struct {
 
 // GetExtended returns extended information for this champion
 func (d *ChampionData) GetExtended(client *Client) (ChampionDataExtended, error) {
-	return client.GetChampion(d.Name)
+	return client.GetChampionByID(d.ID)
 }
 
 // ChampionDataInfo contains information about the playstyle of a champion
@@ -65,9 +65,57 @@ type ChampionDataStats struct {
 	AttackDamage                    float64 `json:"attackdamage"`
 	AttackDamagePerLevel            float64 `json:"attackdamageperlevel"`
 	AttackSpeedOffset               float64 `json:"attackspeedoffset"`
+	AttackSpeed                     float64 `json:"attackspeed"`
 	AttackSpeedPerLevel             float64 `json:"attackspeedperlevel"`
 }
 
+// MaxChampionLevel is the highest level a champion can reach
+const MaxChampionLevel = 18
+
+// ChampionStats contains the stats of a champion at a level
+type ChampionStats struct {
+	Level                   int
+	HealthPoints            float64
+	ManaPoints              float64
+	MovementSpeed           float64
+	Armor                   float64
+	SpellBlock              float64
+	AttackRange             float64
+	HealthPointRegeneration float64
+	ManaPointRegeneration   float64
+	CriticalStrikeChance    float64
+	AttackDamage            float64
+	// Attacks per second
+	AttackSpeed float64
+}
+
+// StatsAtLevel returns the stats of the champion at the given level, which is limited to 1 to MaxChampionLevel. The
+// stats grow by the per level values with the growth formula of the game, so the growth per level increases with the
+// level. The attack speed grows by the per level value as percentage of the base attack speed.
+func (s ChampionDataStats) StatsAtLevel(level int) ChampionStats {
+	level = max(1, min(level, MaxChampionLevel))
+	growth := float64(level-1) * (0.7025 + 0.0175*float64(level-1))
+	attackSpeed := s.AttackSpeed
+	if attackSpeed == 0 {
+		// older versions only contain the offset from the base attack speed of 0.625
+		attackSpeed = 0.625 / (1 + s.AttackSpeedOffset)
+	}
+	return ChampionStats{
+		Level:                   level,
+		HealthPoints:            s.HealthPoints + s.HealthPointsPerLevel*growth,
+		ManaPoints:              s.ManaPoints + s.ManaPointsPerLevel*growth,
+		MovementSpeed:           s.MovementSpeed,
+		Armor:                   s.Armor + s.ArmorPerLevel*growth,
+		SpellBlock:              s.SpellBlock + s.SpellBlockPerLevel*growth,
+		AttackRange:             s.AttackRange,
+		HealthPointRegeneration: s.HealthPointRegeneration + s.HealthPointRegenerationPerLevel*growth,
+		ManaPointRegeneration:   s.ManaPointRegeneration + s.ManaPointRegenerationPerLevel*growth,
+		CriticalStrikeChance:    s.CriticalStrikeChance + s.CriticalStrikeChancePerLevel*growth,
+		AttackDamage:            s.AttackDamage + s.AttackDamagePerLevel*growth,
+		AttackSpeed:             attackSpeed * (1 + s.AttackSpeedPerLevel/100*growth),
+	}
+}
+
 // ChampionDataExtended contains additional data about a champion
 type ChampionDataExtended struct {
 	ChampionData
@@ -194,6 +242,7 @@ type Item struct {
 	Stats            ItemStats       `json:"stats"`
 	Tags             []string        `json:"tags"`
 	Maps             map[string]bool `json:"maps"`
+	Image            ImageData       `json:"image"`
 }
 
 // ItemStats contains information about the stats of an item
@@ -292,6 +341,49 @@ func (i *Integer) UnmarshalJSON(in []byte) error {
 	return err
 }
 
+// RuneTree is a path of Runes Reforged, e.g. Precision
+type RuneTree struct {
+	ID   int    `json:"id"`
+	Key  string `json:"key"`
+	Icon string `json:"icon"`
+	Name string `json:"name"`
+	// The slots of the tree, starting with the keystones
+	Slots []RuneSlot `json:"slots"`
+}
+
+// RuneSlot is a row of a rune tree of which one rune can be selected
+type RuneSlot struct {
+	Runes []Rune `json:"runes"`
+}
+
+// Rune is a rune of Runes Reforged or a stat shard
+type Rune struct {
+	ID        int    `json:"id"`
+	Key       string `json:"key"`
+	Icon      string `json:"icon"`
+	Name      string `json:"name"`
+	ShortDesc string `json:"shortDesc"`
+	LongDesc  string `json:"longDesc"`
+}
+
+// RunePage is the resolved rune selection of a player
+type RunePage struct {
+	Primary   RuneTree
+	Secondary RuneTree
+	// The selected runes, starting with the keystone and the runes of the primary tree
+	Runes []Rune
+	// The selected stat shards in the order offense, flex, defense
+	StatShards []Rune
+}
+
+// Keystone returns the keystone of the page, which is the first rune of the primary tree
+func (p *RunePage) Keystone() (Rune, bool) {
+	if len(p.Runes) == 0 {
+		return Rune{}, false
+	}
+	return p.Runes[0], true
+}
+
 // SummonerSpell represents a summoner spell
 type SummonerSpell struct {
 	ID           string    `json:"id"`
@@ -318,3 +410,59 @@ type SummonerSpell struct {
 	Image         ImageData `json:"image"`
 	Resource      string    `json:"resource"`
 }
+
+// TFTData contains the information shared by all Teamfight Tactics data
+type TFTData struct {
+	ID    string    `json:"id"`
+	Name  string    `json:"name"`
+	Image ImageData `json:"image"`
+	// Set the data belongs to. 0 if it does not belong to a specific set.
+	Set int `json:"-"`
+}
+
+func (d *TFTData) tftData() *TFTData {
+	return d
+}
+
+// TFTChampion contains information about a Teamfight Tactics champion
+type TFTChampion struct {
+	TFTData
+	// Cost of the champion
+	Tier int `json:"tier"`
+}
+
+// TFTItem contains information about a Teamfight Tactics item
+type TFTItem struct {
+	TFTData
+}
+
+// TFTTrait contains information about a Teamfight Tactics trait
+type TFTTrait struct {
+	TFTData
+}
+
+// TFTAugment contains information about a Teamfight Tactics augment
+type TFTAugment struct {
+	TFTData
+}
+
+// TFTTactician contains information about a Teamfight Tactics tactician, also known as Little Legend
+type TFTTactician struct {
+	TFTData
+	// Rarity of the tactician
+	Tier string `json:"tier"`
+}
+
+// TFTArena contains information about a Teamfight Tactics arena
+type TFTArena struct {
+	TFTData
+}
+
+// TFTRegalia contains information about the regalia of a ranked tier
+type TFTRegalia struct {
+	TFTData
+	// Ranked queue of the regalia, e.g. RANKED_TFT
+	Queue string `json:"-"`
+	// Tier of the regalia, e.g. Challenger
+	Tier string `json:"-"`
+}
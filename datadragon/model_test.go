@@ -99,3 +99,61 @@ func TestRecommendedItem_GetItem(t *testing.T) {
 		)
 	}
 }
+
+func TestChampionDataStats_StatsAtLevel(t *testing.T) {
+	t.Parallel()
+	stats := ChampionDataStats{
+		HealthPoints:         590,
+		HealthPointsPerLevel: 104,
+		Armor:                21,
+		ArmorPerLevel:        4.2,
+		AttackDamage:         53,
+		AttackDamagePerLevel: 3,
+		AttackSpeed:          0.668,
+		AttackSpeedPerLevel:  2.2,
+		MovementSpeed:        330,
+	}
+	tests := []struct {
+		name  string
+		level int
+		want  ChampionStats
+	}{
+		{
+			name:  "level 1",
+			level: 1,
+			want: ChampionStats{
+				Level: 1, HealthPoints: 590, Armor: 21, AttackDamage: 53, AttackSpeed: 0.668, MovementSpeed: 330,
+			},
+		},
+		{
+			name:  "level 2",
+			level: 2,
+			want: ChampionStats{
+				Level: 2, HealthPoints: 664.88, Armor: 24.024, AttackDamage: 55.16, AttackSpeed: 0.67858112,
+				MovementSpeed: 330,
+			},
+		},
+		{
+			name:  "above max level",
+			level: 30,
+			want: ChampionStats{
+				Level: 18, HealthPoints: 2358, Armor: 92.4, AttackDamage: 104, AttackSpeed: 0.917832, MovementSpeed: 330,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got := stats.StatsAtLevel(tt.level)
+				assert.Equal(t, tt.want.Level, got.Level)
+				assert.InDelta(t, tt.want.HealthPoints, got.HealthPoints, 1e-9)
+				assert.InDelta(t, tt.want.Armor, got.Armor, 1e-9)
+				assert.InDelta(t, tt.want.AttackDamage, got.AttackDamage, 1e-9)
+				assert.InDelta(t, tt.want.AttackSpeed, got.AttackSpeed, 1e-9)
+				assert.Equal(t, tt.want.MovementSpeed, got.MovementSpeed)
+			},
+		)
+	}
+	assert.InDelta(t, 0.625, ChampionDataStats{}.StatsAtLevel(1).AttackSpeed, 1e-9)
+	assert.InDelta(t, 0.625/0.9, ChampionDataStats{AttackSpeedOffset: -0.1}.StatsAtLevel(1).AttackSpeed, 1e-9)
+}
@@ -0,0 +1,114 @@
+package datadragon
+
+import (
+	"encoding/json"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// StatShards contains the stat shards which can be selected in addition to the runes. They are not part of the
+// Data Dragon data, so their names are only available in English.
+var StatShards = []Rune{
+	{ID: 5001, Key: "HealthScaling", Name: "Health Scaling", ShortDesc: "+10-180 Health (based on level)"},
+	{ID: 5002, Key: "Armor", Name: "Armor", ShortDesc: "+6 Armor"},
+	{ID: 5003, Key: "MagicRes", Name: "Magic Resist", ShortDesc: "+8 Magic Resist"},
+	{ID: 5005, Key: "AttackSpeed", Name: "Attack Speed", ShortDesc: "+10% Attack Speed"},
+	{ID: 5007, Key: "CDRScaling", Name: "Ability Haste", ShortDesc: "+8 Ability Haste"},
+	{ID: 5008, Key: "AdaptiveForce", Name: "Adaptive Force", ShortDesc: "+9 Adaptive Force"},
+	{ID: 5010, Key: "MovementSpeed", Name: "Move Speed", ShortDesc: "+2% Move Speed"},
+	{ID: 5011, Key: "HealthPlus", Name: "Health", ShortDesc: "+65 Health"},
+	{ID: 5013, Key: "Tenacity", Name: "Tenacity and Slow Resist", ShortDesc: "+10% Tenacity and Slow Resist"},
+}
+
+// GetRuneTrees returns all rune trees of Runes Reforged
+func (c *Client) GetRuneTrees() ([]RuneTree, error) {
+	unlock, toggle := internal.RWLockToggle(&c.runeTreesMu)
+	defer unlock()
+	if len(c.runeTrees) < 1 {
+		toggle()
+		// runesReforged.json is not wrapped like the other data files
+		response, err := c.doRequest(dataDragonDataURLFormat, "/runesReforged.json")
+		if err != nil {
+			return nil, err
+		}
+		defer response.Body.Close()
+		var res []RuneTree
+		if err := json.NewDecoder(response.Body).Decode(&res); err != nil {
+			return nil, err
+		}
+		c.runeTrees = res
+	}
+	res := make([]RuneTree, len(c.runeTrees))
+	copy(res, c.runeTrees)
+	return res, nil
+}
+
+// GetRuneTree returns the rune tree with the given id, e.g. 8000 for Precision
+func (c *Client) GetRuneTree(id int) (RuneTree, error) {
+	trees, err := c.GetRuneTrees()
+	if err != nil {
+		return RuneTree{}, err
+	}
+	for _, tree := range trees {
+		if tree.ID == id {
+			return tree, nil
+		}
+	}
+	return RuneTree{}, api.ErrNotFound
+}
+
+// GetReforgedRune returns the rune or stat shard with the given id, e.g. 8005 for Press the Attack
+func (c *Client) GetReforgedRune(id int) (Rune, error) {
+	if shard, ok := statShard(id); ok {
+		return shard, nil
+	}
+	trees, err := c.GetRuneTrees()
+	if err != nil {
+		return Rune{}, err
+	}
+	for _, tree := range trees {
+		for _, slot := range tree.Slots {
+			for _, r := range slot.Runes {
+				if r.ID == id {
+					return r, nil
+				}
+			}
+		}
+	}
+	return Rune{}, api.ErrNotFound
+}
+
+// GetRunePage resolves the trees and perks of a rune page. The perks may contain runes and stat shards in any order,
+// the runes and stat shards of the page keep the order they have in perks.
+func (c *Client) GetRunePage(primaryTree, secondaryTree int, perks []int) (RunePage, error) {
+	var page RunePage
+	var err error
+	if page.Primary, err = c.GetRuneTree(primaryTree); err != nil {
+		return RunePage{}, err
+	}
+	if page.Secondary, err = c.GetRuneTree(secondaryTree); err != nil {
+		return RunePage{}, err
+	}
+	for _, id := range perks {
+		if shard, ok := statShard(id); ok {
+			page.StatShards = append(page.StatShards, shard)
+			continue
+		}
+		r, err := c.GetReforgedRune(id)
+		if err != nil {
+			return RunePage{}, err
+		}
+		page.Runes = append(page.Runes, r)
+	}
+	return page, nil
+}
+
+func statShard(id int) (Rune, bool) {
+	for _, shard := range StatShards {
+		if shard.ID == id {
+			return shard, true
+		}
+	}
+	return Rune{}, false
+}
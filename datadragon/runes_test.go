@@ -0,0 +1,157 @@
+package datadragon
+
+import (
+	"net/http"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+var testRuneTrees = []RuneTree{
+	{
+		ID:   8000,
+		Key:  "Precision",
+		Name: "Precision",
+		Slots: []RuneSlot{
+			{Runes: []Rune{{ID: 8005, Key: "PressTheAttack", Name: "Press the Attack"}}},
+			{Runes: []Rune{{ID: 9111, Key: "Triumph", Name: "Triumph"}}},
+		},
+	},
+	{
+		ID:   8100,
+		Key:  "Domination",
+		Name: "Domination",
+		Slots: []RuneSlot{
+			{Runes: []Rune{{ID: 8112, Key: "Electrocute", Name: "Electrocute"}}},
+			{Runes: []Rune{{ID: 8126, Key: "CheapShot", Name: "Cheap Shot"}}},
+		},
+	},
+}
+
+func TestClient_GetRuneTrees(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []RuneTree
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					// runes reforged are not pinned to the last version of the legacy runes
+					assert.Equal(t, "/cdn/13.24.1/data/en_US/runesReforged.json", r.URL.Path)
+					return mock.NewJSONMockDoer(testRuneTrees, 200).Do(r)
+				},
+			},
+			want: testRuneTrees,
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c := &Client{client: tt.doer, Version: "13.24.1", Language: LanguageCodeUnitedStates}
+				got, err := c.GetRuneTrees()
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, tt.want, got)
+				if tt.wantErr == nil {
+					got, err := c.GetRuneTrees()
+					assert.Nil(t, err)
+					assert.Equal(t, tt.want, got)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_GetReforgedRune(t *testing.T) {
+	t.Parallel()
+	client := NewClient(mock.NewJSONMockDoer(testRuneTrees, 200), api.RegionEuropeWest, log.StandardLogger())
+	tests := []struct {
+		name     string
+		id       int
+		wantName string
+		wantErr  error
+	}{
+		{
+			name:     "keystone",
+			id:       8112,
+			wantName: "Electrocute",
+		},
+		{
+			name:     "minor rune",
+			id:       9111,
+			wantName: "Triumph",
+		},
+		{
+			name:     "stat shard",
+			id:       5008,
+			wantName: "Adaptive Force",
+		},
+		{
+			name:    "not found",
+			id:      1,
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := client.GetReforgedRune(tt.id)
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, tt.wantName, got.Name)
+			},
+		)
+	}
+	tree, err := client.GetRuneTree(8100)
+	require.Nil(t, err)
+	assert.Equal(t, "Domination", tree.Name)
+	_, err = client.GetRuneTree(1)
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_GetRunePage(t *testing.T) {
+	t.Parallel()
+	client := NewClient(mock.NewJSONMockDoer(testRuneTrees, 200), api.RegionEuropeWest, log.StandardLogger())
+	page, err := client.GetRunePage(8100, 8000, []int{8112, 8126, 9111, 5008, 5008, 5002})
+	require.Nil(t, err)
+	assert.Equal(t, "Domination", page.Primary.Name)
+	assert.Equal(t, "Precision", page.Secondary.Name)
+	keystone, ok := page.Keystone()
+	require.True(t, ok)
+	assert.Equal(t, "Electrocute", keystone.Name)
+	assert.Len(t, page.Runes, 3)
+	require.Len(t, page.StatShards, 3)
+	assert.Equal(t, "Armor", page.StatShards[2].Name)
+	_, err = client.GetRunePage(8100, 8000, []int{1})
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = client.GetRunePage(1, 8000, nil)
+	assert.Equal(t, api.ErrNotFound, err)
+	_, ok = (&RunePage{}).Keystone()
+	assert.False(t, ok)
+	client.ClearCaches()
+	assert.Nil(t, client.runeTrees)
+}
+
+func TestClient_newRequest_legacyRunes(t *testing.T) {
+	t.Parallel()
+	c := &Client{Version: "13.24.1", Language: LanguageCodeUnitedStates}
+	request, err := c.newRequest(dataDragonDataURLFormat, "/rune.json")
+	require.Nil(t, err)
+	assert.Equal(t, "/cdn/7.23.1/data/en_US/rune.json", request.URL.Path)
+	request, err = c.newRequest(dataDragonDataURLFormat, "/runesReforged.json")
+	require.Nil(t, err)
+	assert.Equal(t, "/cdn/13.24.1/data/en_US/runesReforged.json", request.URL.Path)
+}
@@ -0,0 +1,428 @@
+package datadragon
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// FSDoer serves the requests of a Client from a snapshot of the Data Dragon files instead of the Data Dragon
+// service. The snapshot has the layout of an extracted dragontail archive: the data of a version and language in
+// <version>/data/<language>/, the images of a version in <version>/img/ and the unversioned images in img/.
+// A versions.json is optional, without it the versions are the names of the version directories.
+type FSDoer struct {
+	fsys fs.FS
+}
+
+// NewFSDoer returns a new FSDoer serving the files of the snapshot, e.g. os.DirFS of an extracted dragontail archive
+// or the result of ReadArchive
+func NewFSDoer(fsys fs.FS) *FSDoer {
+	return &FSDoer{fsys: fsys}
+}
+
+// Do returns the file of the snapshot for the request, or a 404 response if the snapshot does not contain it
+func (d *FSDoer) Do(request *http.Request) (*http.Response, error) {
+	name := snapshotPath(request.URL.Path)
+	data, err := fs.ReadFile(d.fsys, name)
+	if errors.Is(err, fs.ErrNotExist) && name == "versions.json" {
+		data, err = d.versions()
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+			Request:    request,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(data)),
+		Request:    request,
+	}, nil
+}
+
+// versions returns versions.json for the version directories of the snapshot
+func (d *FSDoer) versions() ([]byte, error) {
+	entries, err := fs.ReadDir(d.fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	var versions []string
+	for _, entry := range entries {
+		if _, ok := parseVersion(entry.Name()); ok && entry.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Slice(
+		versions, func(i, j int) bool {
+			return CompareVersions(versions[i], versions[j]) > 0
+		},
+	)
+	return json.Marshal(versions)
+}
+
+// snapshotPath returns the path of the file in a snapshot for the path of a Data Dragon URL
+func snapshotPath(urlPath string) string {
+	urlPath = strings.TrimPrefix(urlPath, "/")
+	switch {
+	case strings.HasPrefix(urlPath, "cdn/"):
+		return strings.TrimPrefix(urlPath, "cdn/")
+	case strings.HasPrefix(urlPath, "api/"):
+		return strings.TrimPrefix(urlPath, "api/")
+	}
+	return urlPath
+}
+
+// NewOfflineClient returns a new client serving the data of the given version and language from a snapshot of the
+// Data Dragon files, see FSDoer. If the version is empty the latest version of the snapshot is used, if the language
+// is empty en_US is used.
+func NewOfflineClient(fsys fs.FS, version string, language languageCode, logger log.FieldLogger) (*Client, error) {
+	c := newClient(NewFSDoer(fsys), logger.WithField("client", "data dragon"))
+	c.Language = language
+	if c.Language == "" {
+		c.Language = fallbackLanguage
+	}
+	c.Version = version
+	if c.Version == "" {
+		var err error
+		if c.Version, err = c.GetLatestVersion(); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// OpenArchive reads the dragontail .tgz archive at the given path like ReadArchive
+func OpenArchive(path string, withImages bool) (fs.FS, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ReadArchive(file, withImages)
+}
+
+// ReadArchive reads a dragontail .tgz archive, or an archive written by Client.ExportSnapshotArchive, into memory and
+// returns its files for NewOfflineClient. Only the JSON files are read unless withImages is set, as the images of a
+// dragontail archive take up several gigabytes. A leading dragontail-<version> directory is removed from the paths.
+func ReadArchive(r io.Reader, withImages bool) (fs.FS, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	files := memFS{}
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(strings.TrimPrefix(header.Name, "./"))
+		if first, rest, ok := strings.Cut(name, "/"); ok && strings.HasPrefix(first, "dragontail-") {
+			name = rest
+		}
+		if !withImages && path.Ext(name) != ".json" || !fs.ValidPath(name) {
+			continue
+		}
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = data
+	}
+	return files, nil
+}
+
+// ExportSnapshot writes the data loaded by the client and by the clients returned by WithLanguage to the directory,
+// in the layout read by FSDoer. A client created by NewOfflineClient for the directory returns the same data for
+// everything that was loaded before the export. Images are not exported.
+func (c *Client) ExportSnapshot(dir string) error {
+	return c.exportSnapshot(
+		func(name string, data []byte) error {
+			file := filepath.Join(dir, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+				return err
+			}
+			return os.WriteFile(file, data, 0o644)
+		},
+	)
+}
+
+// ExportSnapshotArchive writes the data loaded by the client like ExportSnapshot as a .tgz archive, which can be
+// read by ReadArchive
+func (c *Client) ExportSnapshotArchive(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	archive := tar.NewWriter(gz)
+	err := c.exportSnapshot(
+		func(name string, data []byte) error {
+			header := &tar.Header{
+				Typeflag: tar.TypeReg,
+				Name:     name,
+				Mode:     0o644,
+				Size:     int64(len(data)),
+				ModTime:  time.Now(),
+			}
+			if err := archive.WriteHeader(header); err != nil {
+				return err
+			}
+			_, err := archive.Write(data)
+			return err
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if err := archive.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func (c *Client) exportSnapshot(write func(name string, data []byte) error) error {
+	root := c
+	if c.root != nil {
+		root = c.root
+	}
+	versions, err := json.Marshal([]string{root.CurrentVersion()})
+	if err != nil {
+		return err
+	}
+	if err := write("versions.json", versions); err != nil {
+		return err
+	}
+	for _, client := range append([]*Client{root}, root.languageViews()...) {
+		for endpoint, data := range client.snapshotFiles() {
+			request, err := client.newRequest(dataDragonDataURLFormat, endpoint)
+			if err != nil {
+				return err
+			}
+			if endpoint != "/runesReforged.json" {
+				data = dataDragonResponse{
+					Type:    strings.TrimSuffix(path.Base(endpoint), ".json"),
+					Version: client.CurrentVersion(),
+					Data:    data,
+				}
+			}
+			content, err := json.Marshal(data)
+			if err != nil {
+				return err
+			}
+			if err := write(snapshotPath(request.URL.Path), content); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// snapshotFiles returns the content of the data files of all loaded caches by their endpoint
+func (c *Client) snapshotFiles() map[string]interface{} {
+	files := map[string]interface{}{}
+	c.championsMu.RLock()
+	if atomic.LoadUint32(&c.getChampionsToggle) == 1 {
+		champions := map[string]ChampionData{}
+		for id, champion := range c.championsById {
+			champions[id] = champion.ChampionData
+		}
+		files["/champion.json"] = champions
+	}
+	for id, champion := range c.championsById {
+		if champion.Lore != "" {
+			files[fmt.Sprintf("/champion/%s.json", id)] = map[string]ChampionDataExtended{id: champion}
+		}
+	}
+	c.championsMu.RUnlock()
+	exportSlice(files, "/profileicon.json", &c.profileIconsMu, &c.profileIcons, func(icon ProfileIcon) string {
+		return strconv.Itoa(int(icon.ID))
+	})
+	exportSlice(files, "/item.json", &c.itemsMu, &c.items, func(item Item) string { return item.ID })
+	exportSlice(files, "/mastery.json", &c.masteriesMu, &c.masteries, func(mastery Mastery) string {
+		return strconv.Itoa(mastery.ID)
+	})
+	exportSlice(files, "/rune.json", &c.runesMu, &c.runes, func(r Item) string { return r.ID })
+	exportSlice(files, "/summoner.json", &c.summonersMu, &c.summoners, func(spell SummonerSpell) string {
+		return spell.ID
+	})
+	c.runeTreesMu.RLock()
+	if len(c.runeTrees) > 0 {
+		files["/runesReforged.json"] = c.runeTrees
+	}
+	c.runeTreesMu.RUnlock()
+	c.TFT().snapshotFiles(files)
+	return files
+}
+
+// exportSlice adds the entries of a loaded cache to the files by the key returned for each entry
+func exportSlice[T any](
+	files map[string]interface{}, endpoint string, mu *sync.RWMutex, entries *[]T, key func(T) string,
+) {
+	mu.RLock()
+	defer mu.RUnlock()
+	if len(*entries) == 0 {
+		return
+	}
+	res := make(map[string]T, len(*entries))
+	for _, entry := range *entries {
+		res[key(entry)] = entry
+	}
+	files[endpoint] = res
+}
+
+// memFS is a read-only fs.FS of files held in memory by their path
+type memFS map[string][]byte
+
+// Open opens the named file or directory
+func (m memFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if data, ok := m[name]; ok {
+		return &memFile{Reader: bytes.NewReader(data), info: memFileInfo{name: path.Base(name), size: len(data)}}, nil
+	}
+	entries, err := m.ReadDir(name)
+	if err != nil {
+		return nil, err
+	}
+	return &memDir{info: memFileInfo{name: path.Base(name), dir: true}, entries: entries}, nil
+}
+
+// ReadDir returns the entries of the named directory sorted by name
+func (m memFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+	prefix := name + "/"
+	if name == "." {
+		prefix = ""
+	}
+	children := map[string]memFileInfo{}
+	for file, data := range m {
+		rest, ok := strings.CutPrefix(file, prefix)
+		if !ok {
+			continue
+		}
+		if child, _, isDir := strings.Cut(rest, "/"); isDir {
+			children[child] = memFileInfo{name: child, dir: true}
+		} else {
+			children[child] = memFileInfo{name: child, size: len(data)}
+		}
+	}
+	if len(children) == 0 {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	entries := make([]fs.DirEntry, 0, len(children))
+	for _, info := range children {
+		entries = append(entries, fs.FileInfoToDirEntry(info))
+	}
+	sort.Slice(
+		entries, func(i, j int) bool {
+			return entries[i].Name() < entries[j].Name()
+		},
+	)
+	return entries, nil
+}
+
+type memFile struct {
+	*bytes.Reader
+	info memFileInfo
+}
+
+func (f *memFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *memFile) Close() error {
+	return nil
+}
+
+type memDir struct {
+	info    memFileInfo
+	entries []fs.DirEntry
+}
+
+func (d *memDir) Stat() (fs.FileInfo, error) {
+	return d.info, nil
+}
+
+func (d *memDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+func (d *memDir) Close() error {
+	return nil
+}
+
+func (d *memDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if n <= 0 {
+		entries := d.entries
+		d.entries = nil
+		return entries, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	n = min(n, len(d.entries))
+	entries := d.entries[:n]
+	d.entries = d.entries[n:]
+	return entries, nil
+}
+
+type memFileInfo struct {
+	name string
+	size int
+	dir  bool
+}
+
+func (i memFileInfo) Name() string {
+	return i.name
+}
+
+func (i memFileInfo) Size() int64 {
+	return int64(i.size)
+}
+
+func (i memFileInfo) Mode() fs.FileMode {
+	if i.dir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
+
+func (i memFileInfo) ModTime() time.Time {
+	return time.Time{}
+}
+
+func (i memFileInfo) IsDir() bool {
+	return i.dir
+}
+
+func (i memFileInfo) Sys() interface{} {
+	return nil
+}
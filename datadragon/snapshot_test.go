@@ -0,0 +1,248 @@
+package datadragon
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+// snapshotDoer returns a Doer serving a small set of the Data Dragon files
+func snapshotDoer() *mock.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			language := strings.Split(r.URL.Path, "/")[4]
+			var data interface{}
+			switch path.Base(r.URL.Path) {
+			case "champion.json":
+				data = map[string]ChampionData{
+					"Ahri":       {ID: "Ahri", Key: "103", Name: "Ahri " + language},
+					"MonkeyKing": {ID: "MonkeyKing", Key: "62", Name: "Wukong " + language},
+				}
+			case "Ahri.json":
+				data = map[string]ChampionDataExtended{
+					"Ahri": {ChampionData: ChampionData{ID: "Ahri", Key: "103", Name: "Ahri"}, Lore: "Ahri's lore"},
+				}
+			case "item.json":
+				data = map[string]Item{"1001": {Name: "Boots", Tags: []string{"Boots"}}}
+			case "runesReforged.json":
+				return mock.NewJSONMockDoer(testRuneTrees, 200).Do(r)
+			case "tft-champion.json":
+				data = map[string]TFTChampion{
+					"Maps/Shipping/Map22/Sets/TFTSet10/TFT10_Ahri": {TFTData: TFTData{ID: "TFT10_Ahri", Name: "Ahri"}},
+				}
+			case "tft-regalia.json":
+				data = map[string]map[string]TFTRegalia{
+					"RANKED_TFT": {"Challenger": {TFTData: TFTData{ID: "TFT_Regalia_Challenger"}}},
+				}
+			default:
+				return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+			}
+			return dataDragonResponseDoer(data).Do(r)
+		},
+	}
+}
+
+// loadedClient returns a client which has loaded all files of snapshotDoer
+func loadedClient(t *testing.T) *Client {
+	client := newClient(snapshotDoer(), log.StandardLogger())
+	client.Version = "13.24.1"
+	client.Language = LanguageCodeUnitedStates
+	_, err := client.GetChampions()
+	require.Nil(t, err)
+	_, err = client.GetChampionByID("Ahri")
+	require.Nil(t, err)
+	_, err = client.GetItems()
+	require.Nil(t, err)
+	_, err = client.GetRuneTrees()
+	require.Nil(t, err)
+	_, err = client.TFT().GetChampion(10, "TFT10_Ahri")
+	require.Nil(t, err)
+	_, err = client.TFT().GetRegalia()
+	require.Nil(t, err)
+	_, err = client.WithLanguage(LanguageCodeGermany).GetChampions()
+	require.Nil(t, err)
+	return client
+}
+
+// assertSameData asserts that the offline client returns the data loaded by loadedClient
+func assertSameData(t *testing.T, want, got *Client) {
+	assert.Equal(t, "13.24.1", got.Version)
+	for _, language := range []languageCode{LanguageCodeUnitedStates, LanguageCodeGermany} {
+		wantChampions, err := want.WithLanguage(language).GetChampions()
+		require.Nil(t, err)
+		gotChampions, err := got.WithLanguage(language).GetChampions()
+		require.Nil(t, err)
+		assert.ElementsMatch(t, wantChampions, gotChampions)
+	}
+	wantChampion, err := want.GetChampionByID("Ahri")
+	require.Nil(t, err)
+	gotChampion, err := got.GetChampionByID("Ahri")
+	require.Nil(t, err)
+	assert.Equal(t, wantChampion, gotChampion)
+	_, err = got.GetChampionByID("MonkeyKing")
+	assert.Equal(t, api.ErrNotFound, err)
+
+	wantItems, err := want.GetItems()
+	require.Nil(t, err)
+	gotItems, err := got.GetItems()
+	require.Nil(t, err)
+	assert.Equal(t, wantItems, gotItems)
+
+	trees, err := got.GetRuneTrees()
+	require.Nil(t, err)
+	assert.Equal(t, testRuneTrees, trees)
+
+	wantTFTChampion, err := want.TFT().GetChampion(10, "TFT10_Ahri")
+	require.Nil(t, err)
+	gotTFTChampion, err := got.TFT().GetChampion(10, "TFT10_Ahri")
+	require.Nil(t, err)
+	assert.Equal(t, wantTFTChampion, gotTFTChampion)
+	assert.Equal(t, 10, gotTFTChampion.Set)
+
+	wantRegalia, err := want.TFT().GetRegalia()
+	require.Nil(t, err)
+	gotRegalia, err := got.TFT().GetRegalia()
+	require.Nil(t, err)
+	assert.Equal(t, wantRegalia, gotRegalia)
+
+	_, err = got.GetSummonerSpells()
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_ExportSnapshot(t *testing.T) {
+	t.Parallel()
+	client := loadedClient(t)
+	dir := t.TempDir()
+	require.Nil(t, client.ExportSnapshot(dir))
+	_, err := os.Stat(filepath.Join(dir, "13.24.1", "data", "de_DE", "champion.json"))
+	require.Nil(t, err)
+
+	offline, err := NewOfflineClient(os.DirFS(dir), "", "", log.StandardLogger())
+	require.Nil(t, err)
+	assert.Equal(t, languageCode(fallbackLanguage), offline.Language)
+	assertSameData(t, client, offline)
+}
+
+func TestClient_ExportSnapshotArchive(t *testing.T) {
+	t.Parallel()
+	client := loadedClient(t)
+	var buf bytes.Buffer
+	require.Nil(t, client.WithLanguage(LanguageCodeGermany).ExportSnapshotArchive(&buf))
+
+	fsys, err := ReadArchive(&buf, false)
+	require.Nil(t, err)
+	offline, err := NewOfflineClient(fsys, "13.24.1", LanguageCodeUnitedStates, log.StandardLogger())
+	require.Nil(t, err)
+	assertSameData(t, client, offline)
+}
+
+// writeArchive returns a .tgz archive of the given files
+func writeArchive(t *testing.T, files map[string]string) *bytes.Buffer {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	archive := tar.NewWriter(gz)
+	for name, content := range files {
+		require.Nil(t, archive.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}))
+		_, err := archive.Write([]byte(content))
+		require.Nil(t, err)
+	}
+	require.Nil(t, archive.Close())
+	require.Nil(t, gz.Close())
+	return &buf
+}
+
+func TestReadArchive(t *testing.T) {
+	t.Parallel()
+	champions, err := json.Marshal(dataDragonResponse{Data: map[string]ChampionData{"Ahri": {ID: "Ahri", Key: "103"}}})
+	require.Nil(t, err)
+	files := map[string]string{
+		"dragontail-13.24.1/13.24.1/data/en_US/champion.json":      string(champions),
+		"dragontail-13.24.1/13.24.1/data/en_US/champion/Ahri.json": string(champions),
+		"dragontail-13.24.1/13.24.1/img/champion/Ahri.png":         "png",
+		"dragontail-13.24.1/13.23.1/data/en_US/champion.json":      string(champions),
+		"dragontail-13.24.1/lolpatch_7.20/README.json":             "{}",
+		"dragontail-13.24.1/languages.js":                          "",
+	}
+
+	fsys, err := ReadArchive(writeArchive(t, files), false)
+	require.Nil(t, err)
+	require.Nil(
+		t, fstest.TestFS(fsys, "13.24.1/data/en_US/champion.json", "13.23.1/data/en_US/champion.json"),
+	)
+	_, err = fs.Stat(fsys, "13.24.1/img/champion/Ahri.png")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = fs.Stat(fsys, "languages.js")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	client, err := NewOfflineClient(fsys, "", LanguageCodeUnitedStates, log.StandardLogger())
+	require.Nil(t, err)
+	assert.Equal(t, "13.24.1", client.Version)
+	versions, err := client.GetVersions()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"13.24.1", "13.23.1"}, versions)
+	champion, err := client.GetChampionByKey(103)
+	require.Nil(t, err)
+	assert.Equal(t, "Ahri", champion.ID)
+
+	fsys, err = ReadArchive(writeArchive(t, files), true)
+	require.Nil(t, err)
+	content, err := fs.ReadFile(fsys, "13.24.1/img/champion/Ahri.png")
+	require.Nil(t, err)
+	assert.Equal(t, "png", string(content))
+
+	_, err = ReadArchive(strings.NewReader("invalid"), false)
+	assert.NotNil(t, err)
+}
+
+func TestFSDoer_Do(t *testing.T) {
+	t.Parallel()
+	doer := NewFSDoer(
+		fstest.MapFS{
+			"realms/euw.json":                {Data: []byte(`{"v":"13.24.1"}`)},
+			"img/champion/splash/Ahri_0.jpg": {Data: []byte("jpg")},
+		},
+	)
+	tests := []struct {
+		url        string
+		wantStatus int
+		wantBody   string
+	}{
+		{url: "https://ddragon.leagueoflegends.com/realms/euw.json", wantStatus: 200, wantBody: `{"v":"13.24.1"}`},
+		{url: "https://ddragon.leagueoflegends.com/cdn/img/champion/splash/Ahri_0.jpg", wantStatus: 200, wantBody: "jpg"},
+		{url: "https://ddragon.leagueoflegends.com/api/versions.json", wantStatus: 200, wantBody: "null"},
+		{url: "https://ddragon.leagueoflegends.com/cdn/13.24.1/data/en_US/item.json", wantStatus: 404},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.url, func(t *testing.T) {
+				request, err := http.NewRequest(http.MethodGet, tt.url, nil)
+				require.Nil(t, err)
+				response, err := doer.Do(request)
+				require.Nil(t, err)
+				assert.Equal(t, tt.wantStatus, response.StatusCode)
+				var body bytes.Buffer
+				_, err = body.ReadFrom(response.Body)
+				require.Nil(t, err)
+				assert.Equal(t, tt.wantBody, body.String())
+			},
+		)
+	}
+	_, err := NewOfflineClient(fstest.MapFS{}, "", "", log.StandardLogger())
+	assert.Equal(t, api.ErrNotFound, err)
+}
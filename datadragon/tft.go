@@ -0,0 +1,297 @@
+package datadragon
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
+
+var (
+	tftSetFromKey = regexp.MustCompile(`TFTSet(\d+)`)
+	tftSetFromID  = regexp.MustCompile(`^(?:TFT|Set)(\d+)`)
+)
+
+// TFTClient provides access to the Teamfight Tactics data of the Data Dragon service. It uses the version and
+// language of the Client it was created from.
+type TFTClient struct {
+	client     *Client
+	champions  tftCache[TFTChampion, *TFTChampion]
+	items      tftCache[TFTItem, *TFTItem]
+	traits     tftCache[TFTTrait, *TFTTrait]
+	augments   tftCache[TFTAugment, *TFTAugment]
+	tacticians tftCache[TFTTactician, *TFTTactician]
+	arenas     tftCache[TFTArena, *TFTArena]
+	regaliaMu  sync.RWMutex
+	regalia    []TFTRegalia
+}
+
+// NewTFTClient returns a new client for the Teamfight Tactics data of the Data Dragon service. Clients created
+// with NewClient already provide one through Client.TFT, whose caches are also cleared by Client.ClearCaches.
+func NewTFTClient(client *Client) *TFTClient {
+	return &TFTClient{
+		client:     client,
+		champions:  tftCache[TFTChampion, *TFTChampion]{file: "/tft-champion.json"},
+		items:      tftCache[TFTItem, *TFTItem]{file: "/tft-item.json"},
+		traits:     tftCache[TFTTrait, *TFTTrait]{file: "/tft-trait.json"},
+		augments:   tftCache[TFTAugment, *TFTAugment]{file: "/tft-augments.json"},
+		tacticians: tftCache[TFTTactician, *TFTTactician]{file: "/tft-tactician.json"},
+		arenas:     tftCache[TFTArena, *TFTArena]{file: "/tft-arena.json"},
+	}
+}
+
+// GetChampions returns all existing champions of all sets
+func (c *TFTClient) GetChampions() ([]TFTChampion, error) {
+	return c.champions.all(c.client)
+}
+
+// GetChampion returns information about the champion with the given character id in the given set
+func (c *TFTClient) GetChampion(set int, id string) (TFTChampion, error) {
+	return c.champions.find(c.client, set, id)
+}
+
+// GetItems returns all existing items of all sets
+func (c *TFTClient) GetItems() ([]TFTItem, error) {
+	return c.items.all(c.client)
+}
+
+// GetItem returns information about the item with the given name in the given set
+func (c *TFTClient) GetItem(set int, id string) (TFTItem, error) {
+	return c.items.find(c.client, set, id)
+}
+
+// GetTraits returns all existing traits of all sets
+func (c *TFTClient) GetTraits() ([]TFTTrait, error) {
+	return c.traits.all(c.client)
+}
+
+// GetTrait returns information about the trait with the given name in the given set
+func (c *TFTClient) GetTrait(set int, id string) (TFTTrait, error) {
+	return c.traits.find(c.client, set, id)
+}
+
+// GetAugments returns all existing augments of all sets
+func (c *TFTClient) GetAugments() ([]TFTAugment, error) {
+	return c.augments.all(c.client)
+}
+
+// GetAugment returns information about the augment with the given name in the given set
+func (c *TFTClient) GetAugment(set int, id string) (TFTAugment, error) {
+	return c.augments.find(c.client, set, id)
+}
+
+// GetTacticians returns all existing tacticians, also known as Little Legends
+func (c *TFTClient) GetTacticians() ([]TFTTactician, error) {
+	return c.tacticians.all(c.client)
+}
+
+// GetTactician returns information about the tactician with the given id, which is the item id of a companion
+func (c *TFTClient) GetTactician(id string) (TFTTactician, error) {
+	return c.tacticians.find(c.client, 0, id)
+}
+
+// GetArenas returns all existing arenas
+func (c *TFTClient) GetArenas() ([]TFTArena, error) {
+	return c.arenas.all(c.client)
+}
+
+// GetArena returns information about the arena with the given id
+func (c *TFTClient) GetArena(id string) (TFTArena, error) {
+	return c.arenas.find(c.client, 0, id)
+}
+
+// GetRegalia returns the regalia of all ranked queues
+func (c *TFTClient) GetRegalia() ([]TFTRegalia, error) {
+	unlock, toggle := internal.RWLockToggle(&c.regaliaMu)
+	defer unlock()
+	if len(c.regalia) < 1 {
+		toggle()
+		var res map[string]map[string]TFTRegalia
+		if err := c.client.getInto("/tft-regalia.json", &res); err != nil {
+			return nil, err
+		}
+		c.regalia = nil
+		for queue, tiers := range res {
+			for tier, regalia := range tiers {
+				regalia.Queue = queue
+				regalia.Tier = tier
+				c.regalia = append(c.regalia, regalia)
+			}
+		}
+	}
+	res := make([]TFTRegalia, len(c.regalia))
+	copy(res, c.regalia)
+	return res, nil
+}
+
+// GetRegaliaByTier returns the regalia of the given tier in the given ranked queue, e.g. RANKED_TFT and Challenger
+func (c *TFTClient) GetRegaliaByTier(queue, tier string) (TFTRegalia, error) {
+	regalia, err := c.GetRegalia()
+	if err != nil {
+		return TFTRegalia{}, err
+	}
+	for _, r := range regalia {
+		if r.Queue == queue && strings.EqualFold(r.Tier, tier) {
+			return r, nil
+		}
+	}
+	return TFTRegalia{}, api.ErrNotFound
+}
+
+// ImageURL returns the URL of the given image for the version of the client
+func (c *TFTClient) ImageURL(image ImageData) string {
+	return c.client.ImageURL(image)
+}
+
+// ClearCaches resets all caches of the client
+func (c *TFTClient) ClearCaches() {
+	unlock := lockAll(c.mutexes())
+	defer unlock()
+	c.reset()
+}
+
+func (c *TFTClient) mutexes() []*sync.RWMutex {
+	return []*sync.RWMutex{
+		&c.champions.mu, &c.items.mu, &c.traits.mu, &c.augments.mu, &c.tacticians.mu, &c.arenas.mu, &c.regaliaMu,
+	}
+}
+
+// reset resets all caches. The caller has to hold the locks returned by mutexes.
+func (c *TFTClient) reset() {
+	c.champions.entries = nil
+	c.items.entries = nil
+	c.traits.entries = nil
+	c.augments.entries = nil
+	c.tacticians.entries = nil
+	c.arenas.entries = nil
+	c.regalia = nil
+}
+
+// snapshotFiles adds the content of the data files of all loaded caches to the files by their endpoint
+func (c *TFTClient) snapshotFiles(files map[string]interface{}) {
+	c.champions.snapshotFile(files)
+	c.items.snapshotFile(files)
+	c.traits.snapshotFile(files)
+	c.augments.snapshotFile(files)
+	c.tacticians.snapshotFile(files)
+	c.arenas.snapshotFile(files)
+	c.regaliaMu.RLock()
+	defer c.regaliaMu.RUnlock()
+	if len(c.regalia) > 0 {
+		regalia := map[string]map[string]TFTRegalia{}
+		for _, r := range c.regalia {
+			if regalia[r.Queue] == nil {
+				regalia[r.Queue] = map[string]TFTRegalia{}
+			}
+			regalia[r.Queue][r.Tier] = r
+		}
+		files["/tft-regalia.json"] = regalia
+	}
+}
+
+// tftEntry is implemented by pointers to the Teamfight Tactics data types
+type tftEntry[T any] interface {
+	*T
+	tftData() *TFTData
+}
+
+// tftCache caches the entries of a Teamfight Tactics data file
+type tftCache[T any, P tftEntry[T]] struct {
+	mu      sync.RWMutex
+	file    string
+	entries []T
+}
+
+func (t *tftCache[T, P]) all(client *Client) ([]T, error) {
+	unlock, toggle := internal.RWLockToggle(&t.mu)
+	defer unlock()
+	if len(t.entries) < 1 {
+		toggle()
+		var res map[string]T
+		if err := client.getInto(t.file, &res); err != nil {
+			return nil, err
+		}
+		t.entries = make([]T, 0, len(res))
+		for key, entry := range res {
+			data := P(&entry).tftData()
+			data.Set = tftSetNumber(key, data.ID)
+			t.entries = append(t.entries, entry)
+		}
+	}
+	res := make([]T, len(t.entries))
+	copy(res, t.entries)
+	return res, nil
+}
+
+// snapshotFile adds the loaded entries to the files. Entries of a set are keyed by a path containing the set like
+// in the data files of newer versions, so the set is kept.
+func (t *tftCache[T, P]) snapshotFile(files map[string]interface{}) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if len(t.entries) < 1 {
+		return
+	}
+	res := make(map[string]T, len(t.entries))
+	for i := range t.entries {
+		data := P(&t.entries[i]).tftData()
+		key := data.ID
+		if data.Set > 0 {
+			key = fmt.Sprintf("Maps/Shipping/Map22/Sets/TFTSet%d/%s", data.Set, data.ID)
+		}
+		res[key] = t.entries[i]
+	}
+	files[t.file] = res
+}
+
+// find returns the entry with the given id. An entry of the given set is preferred over an entry which does not
+// belong to any set, which is preferred over an entry of any other set. Of the entries of other sets the one of the
+// latest set is returned.
+func (t *tftCache[T, P]) find(client *Client, set int, id string) (T, error) {
+	var res T
+	entries, err := t.all(client)
+	if err != nil {
+		return res, err
+	}
+	rank, resSet := 0, 0
+	for i := range entries {
+		data := P(&entries[i]).tftData()
+		if data.ID != id {
+			continue
+		}
+		var r int
+		switch data.Set {
+		case set:
+			r = 3
+		case 0:
+			r = 2
+		default:
+			r = 1
+		}
+		if r > rank || r == rank && data.Set > resSet {
+			res, rank, resSet = entries[i], r, data.Set
+		}
+	}
+	if rank == 0 {
+		return res, api.ErrNotFound
+	}
+	return res, nil
+}
+
+// tftSetNumber returns the set of an entry of a Teamfight Tactics data file. The key of the entry is the path of
+// the entry for newer versions, which contains the set. Older versions only contain the set as prefix of the id.
+// Entries which do not belong to a set, like most items, return 0.
+func tftSetNumber(key, id string) int {
+	match := tftSetFromKey.FindStringSubmatch(key)
+	if match == nil {
+		match = tftSetFromID.FindStringSubmatch(id)
+	}
+	if match == nil {
+		return 0
+	}
+	set, _ := strconv.Atoi(match[1])
+	return set
+}
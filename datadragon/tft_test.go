@@ -0,0 +1,279 @@
+package datadragon
+
+import (
+	"net/http"
+	"sort"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func newTestTFTClient(doer internal.Doer) *TFTClient {
+	return NewTFTClient(NewClient(doer, api.RegionEuropeWest, log.StandardLogger()))
+}
+
+func TestTFTClient_GetChampions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []TFTChampion
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(
+				map[string]TFTChampion{
+					"Maps/Shipping/Map22/Sets/TFTSet10/Shop/TFT10_Ahri": {TFTData: TFTData{ID: "TFT10_Ahri"}, Tier: 4},
+					"TFT9_Ahri": {TFTData: TFTData{ID: "TFT9_Ahri"}, Tier: 3},
+				},
+			),
+			want: []TFTChampion{
+				{TFTData: TFTData{ID: "TFT10_Ahri", Set: 10}, Tier: 4},
+				{TFTData: TFTData{ID: "TFT9_Ahri", Set: 9}, Tier: 3},
+			},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := newTestTFTClient(tt.doer).GetChampions()
+				assert.Equal(t, tt.wantErr, err)
+				sort.Slice(
+					got, func(i, j int) bool {
+						return got[i].ID < got[j].ID
+					},
+				)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+}
+
+func TestTFTClient_GetItem(t *testing.T) {
+	t.Parallel()
+	client := newTestTFTClient(
+		dataDragonResponseDoer(
+			map[string]TFTItem{
+				"Maps/Shipping/Map22/Sets/TFTSet9/TFT_Item_Radiant": {TFTData: TFTData{ID: "TFT_Item_Radiant", Name: "9"}},
+				"Maps/Shipping/Map22/Sets/TFTSet10/TFT_Item_Radiant": {
+					TFTData: TFTData{ID: "TFT_Item_Radiant", Name: "10"},
+				},
+				"TFT_Item_Warmogs": {TFTData: TFTData{ID: "TFT_Item_Warmogs", Name: "Warmog's Armor"}},
+			},
+		),
+	)
+	tests := []struct {
+		name     string
+		set      int
+		id       string
+		wantName string
+		wantErr  error
+	}{
+		{
+			name:     "same set",
+			set:      9,
+			id:       "TFT_Item_Radiant",
+			wantName: "9",
+		},
+		{
+			name:     "other set",
+			set:      11,
+			id:       "TFT_Item_Radiant",
+			wantName: "10",
+		},
+		{
+			name:     "set not given",
+			id:       "TFT_Item_Radiant",
+			wantName: "10",
+		},
+		{
+			name:     "no set",
+			set:      9,
+			id:       "TFT_Item_Warmogs",
+			wantName: "Warmog's Armor",
+		},
+		{
+			name:    "not found",
+			set:     9,
+			id:      "TFT_Item_Unknown",
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := client.GetItem(tt.set, tt.id)
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, tt.wantName, got.Name)
+			},
+		)
+	}
+}
+
+func TestTFTClient_Lookups(t *testing.T) {
+	t.Parallel()
+	client := newTestTFTClient(
+		dataDragonResponseDoer(
+			map[string]TFTData{
+				"Set9_Bruiser":      {ID: "Set9_Bruiser", Name: "Bruiser"},
+				"TFT9_Augment_Tiny": {ID: "TFT9_Augment_Tiny", Name: "Tiny Titans"},
+				"TFT9_Sett":         {ID: "TFT9_Sett", Name: "Sett"},
+			},
+		),
+	)
+	trait, err := client.GetTrait(9, "Set9_Bruiser")
+	require.Nil(t, err)
+	assert.Equal(t, TFTData{ID: "Set9_Bruiser", Name: "Bruiser", Set: 9}, trait.TFTData)
+	augment, err := client.GetAugment(9, "TFT9_Augment_Tiny")
+	require.Nil(t, err)
+	assert.Equal(t, "Tiny Titans", augment.Name)
+	champion, err := client.GetChampion(9, "TFT9_Sett")
+	require.Nil(t, err)
+	assert.Equal(t, "Sett", champion.Name)
+	traits, err := client.GetTraits()
+	require.Nil(t, err)
+	assert.Len(t, traits, 3)
+	augments, err := client.GetAugments()
+	require.Nil(t, err)
+	assert.Len(t, augments, 3)
+	items, err := client.GetItems()
+	require.Nil(t, err)
+	assert.Len(t, items, 3)
+	client.ClearCaches()
+	assert.Nil(t, client.traits.entries)
+}
+
+func TestTFTClient_GetTactician(t *testing.T) {
+	t.Parallel()
+	client := newTestTFTClient(
+		dataDragonResponseDoer(
+			map[string]TFTTactician{
+				"1": {TFTData: TFTData{ID: "1", Name: "Silverwing"}, Tier: "1"},
+			},
+		),
+	)
+	tactician, err := client.GetTactician("1")
+	require.Nil(t, err)
+	assert.Equal(t, TFTTactician{TFTData: TFTData{ID: "1", Name: "Silverwing"}, Tier: "1"}, tactician)
+	arena, err := client.GetArena("1")
+	require.Nil(t, err)
+	assert.Equal(t, "Silverwing", arena.Name)
+	_, err = client.GetTactician("2")
+	assert.Equal(t, api.ErrNotFound, err)
+	tacticians, err := client.GetTacticians()
+	require.Nil(t, err)
+	assert.Len(t, tacticians, 1)
+	arenas, err := client.GetArenas()
+	require.Nil(t, err)
+	assert.Len(t, arenas, 1)
+}
+
+func TestTFTClient_GetRegalia(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		queue   string
+		tier    string
+		want    TFTRegalia
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: dataDragonResponseDoer(
+				map[string]map[string]TFTData{
+					"RANKED_TFT": {
+						"Challenger": {ID: "TFT_Regalia_Challenger"},
+						"Iron":       {ID: "TFT_Regalia_Iron"},
+					},
+					"RANKED_TFT_TURBO": {
+						"Blue": {ID: "TFT_Regalia_Turbo_Blue"},
+					},
+				},
+			),
+			queue: "RANKED_TFT",
+			tier:  "CHALLENGER",
+			want: TFTRegalia{
+				TFTData: TFTData{ID: "TFT_Regalia_Challenger"},
+				Queue:   "RANKED_TFT",
+				Tier:    "Challenger",
+			},
+		},
+		{
+			name:    "not found",
+			doer:    dataDragonResponseDoer(map[string]map[string]TFTData{}),
+			queue:   "RANKED_TFT",
+			tier:    "Challenger",
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := newTestTFTClient(tt.doer).GetRegaliaByTier(tt.queue, tt.tier)
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+}
+
+func TestClient_TFT(t *testing.T) {
+	t.Parallel()
+	client := NewClient(
+		dataDragonResponseDoer(map[string]TFTData{"1": {ID: "1"}}), api.RegionEuropeWest, log.StandardLogger(),
+	)
+	require.Same(t, client.TFT(), client.TFT())
+	_, err := client.TFT().GetArenas()
+	require.Nil(t, err)
+	assert.NotEmpty(t, client.TFT().arenas.entries)
+	client.ClearCaches()
+	assert.Nil(t, client.TFT().arenas.entries)
+}
+
+func TestTFTClient_ImageURL(t *testing.T) {
+	t.Parallel()
+	client := NewTFTClient(&Client{Version: "13.24.1"})
+	assert.Equal(
+		t, "https://ddragon.leagueoflegends.com/cdn/13.24.1/img/tft-champion/TFT10_Ahri.TFT_Set10.png",
+		client.ImageURL(ImageData{Full: "TFT10_Ahri.TFT_Set10.png", Group: "tft-champion"}),
+	)
+}
+
+func Test_tftSetNumber(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		key  string
+		id   string
+		want int
+	}{
+		{key: "Maps/Shipping/Map22/Sets/TFTSet10/Shop/TFT10_Ahri", id: "TFT10_Ahri", want: 10},
+		{key: "TFT9_Ahri", id: "TFT9_Ahri", want: 9},
+		{key: "Set9_Bruiser", id: "Set9_Bruiser", want: 9},
+		{key: "TFT_Item_Warmogs", id: "TFT_Item_Warmogs", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.key, func(t *testing.T) {
+				assert.Equal(t, tt.want, tftSetNumber(tt.key, tt.id))
+			},
+		)
+	}
+}
@@ -0,0 +1,192 @@
+package datadragon
+
+import (
+	"html"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TooltipFormat is the markup of a rendered tooltip
+type TooltipFormat int
+
+// All tooltip formats
+const (
+	// TooltipFormatText removes all markup. Line breaks and list items become new lines.
+	TooltipFormatText TooltipFormat = iota
+	// TooltipFormatHTML converts the custom markup of the Data Dragon, like <magicDamage>, to span elements with the
+	// name of the tag as class, e.g. <span class="magicDamage">, so it can be styled with CSS
+	TooltipFormatHTML
+)
+
+var (
+	tooltipPlaceholder = regexp.MustCompile(`\{\{\s*(?:([a-zA-Z]+?)(\d*)(NL)?\s*(?:\*\s*(-?[\d.]+)\s*)?|[^}]*)\}\}`)
+	tooltipTag         = regexp.MustCompile(`<(/?)([a-zA-Z]+)[^>]*?(/?)>`)
+)
+
+// scalingNames are the names of the stats a spell scales with by the link of its vars
+var scalingNames = map[string]string{
+	"spelldamage":       "AP",
+	"attackdamage":      "AD",
+	"bonusattackdamage": "bonus AD",
+	"armor":             "armor",
+	"bonusarmor":        "bonus armor",
+	"spellblock":        "magic resist",
+	"bonusspellblock":   "bonus magic resist",
+	"health":            "max health",
+	"bonushealth":       "bonus health",
+	"mana":              "max mana",
+}
+
+// RenderTooltip returns the tooltip of the spell with its placeholders replaced by the values at the given rank,
+// starting at 1, and its markup converted to the given format. For a rank of 0 the values of all ranks are listed
+// like in the burn strings, e.g. 60/90/120. See Render for the supported placeholders.
+func (s SpellData) RenderTooltip(rank int, format TooltipFormat) string {
+	return s.Render(s.Tooltip, rank, format)
+}
+
+// Render renders a text of the spell, like its tooltip, resource or level tip effects, like RenderTooltip. The
+// supported placeholders are:
+//   - {{ eN }}: the Nth effect
+//   - {{ aN }} and {{ fN }}: the coefficient of the var with the key, e.g. 60% AP
+//   - {{ cost }}, {{ cooldown }}, {{ range }} and {{ maxammo }}
+//
+// An NL suffix like {{ e1NL }} refers to the value at the next rank and a multiplier like {{ e1*100 }} is applied to
+// the value. Placeholders which can not be resolved from the Data Dragon data, e.g. the calculations of newer
+// tooltips, are replaced by a question mark like in the game client.
+func (s SpellData) Render(text string, rank int, format TooltipFormat) string {
+	text = tooltipPlaceholder.ReplaceAllStringFunc(
+		text, func(placeholder string) string {
+			match := tooltipPlaceholder.FindStringSubmatch(placeholder)
+			if match[1] == "" {
+				return "?"
+			}
+			r := rank
+			if match[3] != "" && r > 0 {
+				r++
+			}
+			factor := 1.0
+			if match[4] != "" {
+				if f, err := strconv.ParseFloat(match[4], 64); err == nil {
+					factor = f
+				}
+			}
+			value, ok := s.placeholderValue(match[1], match[2], r, factor)
+			if !ok {
+				return "?"
+			}
+			return value
+		},
+	)
+	return convertMarkup(text, format)
+}
+
+// placeholderValue returns the value of the placeholder with the given name and index at the given rank
+func (s SpellData) placeholderValue(name, index string, rank int, factor float64) (string, bool) {
+	switch strings.ToLower(name) {
+	case "cost":
+		return s.rankValue(s.Cost, s.CostBurn, rank, factor)
+	case "cooldown":
+		return s.rankValue(s.Cooldown, s.CooldownBurn, rank, factor)
+	case "range":
+		return s.rankValue(s.Range, s.RangeBurn, rank, factor)
+	case "maxammo":
+		return s.MaxAmmo, s.MaxAmmo != ""
+	}
+	i, err := strconv.Atoi(index)
+	if err != nil {
+		return "", false
+	}
+	switch name {
+	case "e":
+		var values []float64
+		var burn string
+		if i < len(s.Effect) {
+			values = s.Effect[i]
+		}
+		if i < len(s.EffectBurn) {
+			burn = s.EffectBurn[i]
+		}
+		return s.rankValue(values, burn, rank, factor)
+	case "a", "f":
+		key := name + index
+		for _, v := range s.Vars {
+			if v.Key != key {
+				continue
+			}
+			if scaling, ok := scalingNames[v.Link]; ok {
+				return formatTooltipNumber(v.Coefficient*factor*100) + "% " + scaling, true
+			}
+			return formatTooltipNumber(v.Coefficient * factor), true
+		}
+	}
+	return "", false
+}
+
+// rankValue returns the value at the rank, or all values joined by slashes for rank 0. The burn string is used
+// if there are no values.
+func (s SpellData) rankValue(values []float64, burn string, rank int, factor float64) (string, bool) {
+	if len(values) == 0 {
+		return burn, burn != "" && factor == 1
+	}
+	if rank > 0 {
+		return formatTooltipNumber(values[min(rank, len(values))-1] * factor), true
+	}
+	if s.MaxRank > 0 && s.MaxRank < len(values) {
+		values = values[:s.MaxRank]
+	}
+	same := true
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = formatTooltipNumber(value * factor)
+		same = same && value == values[0]
+	}
+	if same {
+		return parts[0], true
+	}
+	return strings.Join(parts, "/"), true
+}
+
+// formatTooltipNumber formats the number with at most two decimals
+func formatTooltipNumber(f float64) string {
+	return strconv.FormatFloat(math.Round(f*100)/100, 'f', -1, 64)
+}
+
+// convertMarkup converts the markup of a tooltip to the format. The text between the tags is escaped for HTML.
+func convertMarkup(text string, format TooltipFormat) string {
+	var sb strings.Builder
+	last := 0
+	for _, match := range tooltipTag.FindAllStringSubmatchIndex(text, -1) {
+		writeTooltipText(&sb, text[last:match[0]], format)
+		last = match[1]
+		closing := match[3] > match[2]
+		selfClosing := match[7] > match[6]
+		tag := strings.ToLower(text[match[4]:match[5]])
+		switch {
+		case tag == "br":
+			if format == TooltipFormatHTML {
+				sb.WriteString("<br>")
+			} else {
+				sb.WriteString("\n")
+			}
+		case tag == "li" && !closing && format == TooltipFormatText:
+			sb.WriteString("\n")
+		case format == TooltipFormatText || selfClosing:
+		case closing:
+			sb.WriteString("</span>")
+		default:
+			sb.WriteString(`<span class="` + text[match[4]:match[5]] + `">`)
+		}
+	}
+	writeTooltipText(&sb, text[last:], format)
+	return strings.TrimSpace(sb.String())
+}
+
+func writeTooltipText(sb *strings.Builder, text string, format TooltipFormat) {
+	text = html.UnescapeString(text)
+	if format == TooltipFormatHTML {
+		text = html.EscapeString(text)
+	}
+	sb.WriteString(text)
+}
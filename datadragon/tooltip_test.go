@@ -0,0 +1,99 @@
+package datadragon
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTooltip = "Deals <magicDamage>{{ e1 }} (+{{ a1 }}) magic damage</magicDamage>.<br /><br />" +
+	"Cooldown: {{ cooldown }}s, ratio {{ e2*100 }}% {{ totaldamage }}"
+
+const testSpell = `{
+	"id": "AhriQ",
+	"tooltip": "` + testTooltip + `",
+	"maxrank": 5,
+	"cooldown": [7, 7, 7, 7, 7],
+	"cost": [55, 60, 65, 70, 75],
+	"effect": [null, [40, 65, 90, 115, 140], [0.5, 0.55, 0.6, 0.65, 0.7]],
+	"effectBurn": [null, "40/65/90/115/140", "0.5/0.55/0.6/0.65/0.7", "3"],
+	"vars": [{"link": "spelldamage", "coeff": 0.45, "key": "a1"}, {"link": "@text", "coeff": 2, "key": "f1"}],
+	"resource": "{{ cost }} Mana"
+}`
+
+func TestSpellData_RenderTooltip(t *testing.T) {
+	t.Parallel()
+	var spell SpellData
+	require.Nil(t, json.Unmarshal([]byte(testSpell), &spell))
+	tests := []struct {
+		name   string
+		rank   int
+		format TooltipFormat
+		want   string
+	}{
+		{
+			name: "all ranks",
+			want: "Deals 40/65/90/115/140 (+45% AP) magic damage.\n\nCooldown: 7s, ratio 50/55/60/65/70% ?",
+		},
+		{
+			name: "first rank",
+			rank: 1,
+			want: "Deals 40 (+45% AP) magic damage.\n\nCooldown: 7s, ratio 50% ?",
+		},
+		{
+			name: "rank above max rank",
+			rank: 9,
+			want: "Deals 140 (+45% AP) magic damage.\n\nCooldown: 7s, ratio 70% ?",
+		},
+		{
+			name:   "html",
+			rank:   5,
+			format: TooltipFormatHTML,
+			want:   `Deals <span class="magicDamage">140 (+45% AP) magic damage</span>.<br><br>Cooldown: 7s, ratio 70% ?`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, spell.RenderTooltip(tt.rank, tt.format))
+			},
+		)
+	}
+}
+
+func TestSpellData_Render(t *testing.T) {
+	t.Parallel()
+	var spell SpellData
+	require.Nil(t, json.Unmarshal([]byte(testSpell), &spell))
+	tests := []struct {
+		name   string
+		text   string
+		rank   int
+		format TooltipFormat
+		want   string
+	}{
+		{name: "resource", text: spell.Resource, rank: 2, want: "60 Mana"},
+		{name: "next level", text: "{{ e1 }} -> {{ e1NL }}", rank: 2, want: "65 -> 90"},
+		{name: "next level at max rank", text: "{{ costNL }}", rank: 5, want: "75"},
+		{name: "burn", text: "{{ e3 }}", rank: 1, want: "3"},
+		{name: "burn with multiplier", text: "{{ e3*2 }}", rank: 1, want: "?"},
+		{name: "unknown link", text: "{{ f1 }}", want: "2"},
+		{name: "unknown effect", text: "{{ e7 }} {{ a9 }} {{ spell.ahri:e1 }}", want: "? ? ?"},
+		{name: "text", text: "a &amp; b<li>c</li><span class=\"x\">d</span>", want: "a & b\ncd"},
+		{
+			name:   "html",
+			text:   "a &amp; b<li>c</li><status>d</status>",
+			format: TooltipFormatHTML,
+			want:   `a &amp; b<span class="li">c</span><span class="status">d</span>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, spell.Render(tt.text, tt.rank, tt.format))
+			},
+		)
+	}
+}
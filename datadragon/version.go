@@ -0,0 +1,241 @@
+package datadragon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// ErrInvalidInterval is returned by StartRefresher for an interval which is not positive
+var ErrInvalidInterval = errors.New("refresh interval must be positive")
+
+// VersionChange is passed to the subscribers of a Client when its version changes
+type VersionChange struct {
+	Old string
+	New string
+}
+
+// GetVersions returns all versions of the Data Dragon data, starting with the latest version
+func (c *Client) GetVersions() ([]string, error) {
+	response, err := c.doRequest(dataDragonBaseURL, "/api/versions.json")
+	if err != nil {
+		return nil, err
+	}
+	if response.Body == nil {
+		return nil, fmt.Errorf("no response body")
+	}
+	var versions []string
+	if err := json.NewDecoder(response.Body).Decode(&versions); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// GetLatestVersion returns the latest version of the Data Dragon data
+func (c *Client) GetLatestVersion() (string, error) {
+	versions, err := c.GetVersions()
+	if err != nil {
+		return "", err
+	}
+	var latest string
+	for _, version := range versions {
+		// versions.json also lists some very old patches as e.g. lolpatch_7.20, which are skipped
+		if _, ok := parseVersion(version); ok && CompareVersions(version, latest) > 0 {
+			latest = version
+		}
+	}
+	if latest == "" {
+		return "", api.ErrNotFound
+	}
+	return latest, nil
+}
+
+// CurrentVersion returns the version currently used by the client. Use it instead of reading Version if the version
+// may be changed concurrently, e.g. by the refresher.
+func (c *Client) CurrentVersion() string {
+	c.versionMu.RLock()
+	defer c.versionMu.RUnlock()
+	return c.Version
+}
+
+// SetVersion changes the version used by the client and the clients returned by WithLanguage. If the version
+// differs from the current version all caches are reset at once, so no data of the previous version is returned
+// afterwards, and the subscribers are notified.
+func (c *Client) SetVersion(version string) {
+	var old string
+	c.swapCaches(
+		func() {
+			c.versionMu.Lock()
+			defer c.versionMu.Unlock()
+			old = c.Version
+			c.Version = version
+		},
+	)
+	for _, view := range c.languageViews() {
+		view.SetVersion(version)
+	}
+	if old != version {
+		c.notify(VersionChange{Old: old, New: version})
+	}
+}
+
+// PinVersion sets the version of the client like SetVersion and keeps Refresh from changing it until
+// UnpinVersion is called
+func (c *Client) PinVersion(version string) {
+	c.versionMu.Lock()
+	c.pinned = true
+	c.versionMu.Unlock()
+	c.SetVersion(version)
+}
+
+// UnpinVersion allows Refresh to change the version of the client again
+func (c *Client) UnpinVersion() {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+	c.pinned = false
+}
+
+// Pinned returns whether the version of the client is pinned
+func (c *Client) Pinned() bool {
+	c.versionMu.RLock()
+	defer c.versionMu.RUnlock()
+	return c.pinned
+}
+
+// Refresh changes the version of the client to the latest version if it is newer than the current version and the
+// version is not pinned. It returns whether the version was changed.
+func (c *Client) Refresh() (bool, error) {
+	if c.Pinned() {
+		return false, nil
+	}
+	latest, err := c.GetLatestVersion()
+	if err != nil {
+		return false, err
+	}
+	if CompareVersions(latest, c.CurrentVersion()) <= 0 || c.Pinned() {
+		return false, nil
+	}
+	c.SetVersion(latest)
+	return true, nil
+}
+
+// StartRefresher calls Refresh at the given interval in the background until the context is done, so a long-running
+// client follows new patches. Failed refreshes are logged and retried at the next interval. ErrInvalidInterval is
+// returned if the interval is not positive.
+func (c *Client) StartRefresher(ctx context.Context, interval time.Duration) error {
+	logger := c.logger.WithField("method", "StartRefresher")
+	if interval <= 0 {
+		logger.Debug(ErrInvalidInterval)
+		return ErrInvalidInterval
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+			if _, err := c.Refresh(); err != nil {
+				logger.Debug(err)
+			}
+		}
+	}()
+	return nil
+}
+
+// Subscribe registers a function which is called after every change of the version of the client. The function is
+// called on the goroutine which changed the version. The returned function removes the subscription.
+func (c *Client) Subscribe(subscriber func(VersionChange)) (unsubscribe func()) {
+	c.subscribersMu.Lock()
+	defer c.subscribersMu.Unlock()
+	if c.subscribers == nil {
+		c.subscribers = map[int]func(VersionChange){}
+	}
+	id := c.nextSubscriber
+	c.nextSubscriber++
+	c.subscribers[id] = subscriber
+	return func() {
+		c.subscribersMu.Lock()
+		defer c.subscribersMu.Unlock()
+		delete(c.subscribers, id)
+	}
+}
+
+func (c *Client) notify(change VersionChange) {
+	c.subscribersMu.Lock()
+	subscribers := make([]func(VersionChange), 0, len(c.subscribers))
+	for _, subscriber := range c.subscribers {
+		subscribers = append(subscribers, subscriber)
+	}
+	c.subscribersMu.Unlock()
+	for _, subscriber := range subscribers {
+		subscriber(change)
+	}
+}
+
+// CompareVersions compares two versions like 13.24.1 part by part numerically. It returns a negative number if v1 is
+// lower than v2, a positive number if v1 is greater than v2 and 0 if they are equal. Missing parts count as 0, so
+// 13.24 and 13.24.0 are equal. Invalid versions are lower than all valid versions and compared lexically among each
+// other.
+func CompareVersions(v1, v2 string) int {
+	parts1, ok1 := parseVersion(v1)
+	parts2, ok2 := parseVersion(v2)
+	switch {
+	case !ok1 && !ok2:
+		return strings.Compare(v1, v2)
+	case !ok1:
+		return -1
+	case !ok2:
+		return 1
+	}
+	for i := 0; i < len(parts1) || i < len(parts2); i++ {
+		var p1, p2 int
+		if i < len(parts1) {
+			p1 = parts1[i]
+		}
+		if i < len(parts2) {
+			p2 = parts2[i]
+		}
+		if p1 != p2 {
+			return p1 - p2
+		}
+	}
+	return 0
+}
+
+func parseVersion(version string) ([]int, bool) {
+	if version == "" {
+		return nil, false
+	}
+	split := strings.Split(version, ".")
+	parts := make([]int, 0, len(split))
+	for _, s := range split {
+		part, err := strconv.Atoi(s)
+		if err != nil || part < 0 {
+			return nil, false
+		}
+		parts = append(parts, part)
+	}
+	return parts, true
+}
+
+// lockAll locks all given mutexes for writing and returns a function unlocking them
+func lockAll(mutexes []*sync.RWMutex) (unlock func()) {
+	for _, mu := range mutexes {
+		mu.Lock()
+	}
+	return func() {
+		for _, mu := range mutexes {
+			mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package datadragon
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+// versionsDoer returns a Doer which returns the given versions for versions.json and the given champions otherwise
+func versionsDoer(versions *[]string, mu *sync.Mutex, champions map[string]ChampionData) internal.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if r.URL.Path == "/api/versions.json" {
+				mu.Lock()
+				defer mu.Unlock()
+				return mock.NewJSONMockDoer(*versions, 200).Do(r)
+			}
+			return dataDragonResponseDoer(champions).Do(r)
+		},
+	}
+}
+
+func newVersionClient(doer internal.Doer, version string) *Client {
+	return &Client{
+		client:        doer,
+		logger:        log.StandardLogger(),
+		Version:       version,
+		Language:      LanguageCodeUnitedStates,
+		championsById: map[string]ChampionDataExtended{},
+	}
+}
+
+func TestClient_GetLatestVersion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    string
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: mock.NewJSONMockDoer([]string{"13.24.1", "14.1.1", "13.9.1", "lolpatch_7.20"}, 200),
+			want: "14.1.1",
+		},
+		{
+			name:    "no valid version",
+			doer:    mock.NewJSONMockDoer([]string{"lolpatch_7.20"}, 200),
+			wantErr: api.ErrNotFound,
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := newVersionClient(tt.doer, "").GetLatestVersion()
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+}
+
+func TestNewClient_latestVersionFallback(t *testing.T) {
+	t.Parallel()
+	logger, hook := test.NewNullLogger()
+	client := NewClient(
+		&mock.Doer{
+			Custom: func(r *http.Request) (*http.Response, error) {
+				if r.URL.Path == "/api/versions.json" {
+					return mock.NewJSONMockDoer([]string{"14.1.1"}, 200).Do(r)
+				}
+				return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+			},
+		}, api.RegionEuropeWest, logger,
+	)
+	assert.Equal(t, "14.1.1", client.CurrentVersion())
+	assert.Equal(t, languageCode(fallbackLanguage), client.Language)
+	require.NotNil(t, hook.LastEntry())
+	assert.Equal(t, log.WarnLevel, hook.LastEntry().Level)
+	assert.Contains(t, hook.LastEntry().Message, "14.1.1")
+
+	hook.Reset()
+	client = NewClient(mock.NewStatusMockDoer(http.StatusNotFound), api.RegionEuropeWest, logger)
+	assert.Equal(t, fallbackVersion, client.CurrentVersion())
+	require.NotNil(t, hook.LastEntry())
+	assert.Equal(t, log.WarnLevel, hook.LastEntry().Level)
+	assert.Contains(t, hook.LastEntry().Message, fallbackVersion)
+}
+
+func TestClient_SetVersion(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	versions := []string{"14.1.1"}
+	client := newVersionClient(
+		versionsDoer(&versions, &mu, map[string]ChampionData{"Ahri": {ID: "Ahri", Key: "103", Name: "Ahri"}}),
+		"13.24.1",
+	)
+	var changes []VersionChange
+	unsubscribe := client.Subscribe(
+		func(change VersionChange) {
+			changes = append(changes, change)
+		},
+	)
+	_, err := client.GetChampions()
+	require.Nil(t, err)
+	require.NotEmpty(t, client.championsById)
+
+	client.SetVersion("14.1.1")
+	assert.Equal(t, "14.1.1", client.CurrentVersion())
+	assert.Empty(t, client.championsById)
+	client.SetVersion("14.1.1")
+	assert.Equal(t, []VersionChange{{Old: "13.24.1", New: "14.1.1"}}, changes)
+
+	unsubscribe()
+	client.SetVersion("14.2.1")
+	assert.Len(t, changes, 1)
+}
+
+func TestClient_Refresh(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	versions := []string{"13.24.1"}
+	client := newVersionClient(versionsDoer(&versions, &mu, map[string]ChampionData{}), "13.24.1")
+
+	changed, err := client.Refresh()
+	require.Nil(t, err)
+	assert.False(t, changed)
+
+	versions = []string{"14.1.1", "13.24.1"}
+	client.PinVersion("13.23.1")
+	assert.True(t, client.Pinned())
+	changed, err = client.Refresh()
+	require.Nil(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, "13.23.1", client.CurrentVersion())
+
+	client.UnpinVersion()
+	changed, err = client.Refresh()
+	require.Nil(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "14.1.1", client.CurrentVersion())
+
+	_, err = newVersionClient(mock.NewStatusMockDoer(http.StatusForbidden), "13.24.1").Refresh()
+	assert.Equal(t, api.ErrForbidden, err)
+}
+
+func TestClient_StartRefresher(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	versions := []string{"13.24.1"}
+	client := newVersionClient(versionsDoer(&versions, &mu, map[string]ChampionData{}), "13.24.1")
+	changes := make(chan VersionChange, 1)
+	client.Subscribe(
+		func(change VersionChange) {
+			changes <- change
+		},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.Nil(t, client.StartRefresher(ctx, time.Millisecond))
+	mu.Lock()
+	versions = []string{"14.1.1"}
+	mu.Unlock()
+	select {
+	case change := <-changes:
+		assert.Equal(t, VersionChange{Old: "13.24.1", New: "14.1.1"}, change)
+	case <-time.After(5 * time.Second):
+		t.Fatal("version was not refreshed")
+	}
+	assert.Equal(t, ErrInvalidInterval, client.StartRefresher(ctx, 0))
+	assert.Equal(t, ErrInvalidInterval, client.StartRefresher(ctx, -time.Second))
+}
+
+func TestCompareVersions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		v1   string
+		v2   string
+		want int
+	}{
+		{v1: "13.24.1", v2: "13.24.1", want: 0},
+		{v1: "13.24", v2: "13.24.0", want: 0},
+		{v1: "7.10.1", v2: "7.2.5", want: 1},
+		{v1: "7.2.5", v2: "7.10.1", want: -1},
+		{v1: "14.1.1", v2: "13.24.1", want: 1},
+		{v1: "lolpatch_7.20", v2: "0.151.2", want: -1},
+		{v1: "1", v2: "a", want: 1},
+		{v1: "", v2: "", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.v1+" "+tt.v2, func(t *testing.T) {
+				got := CompareVersions(tt.v1, tt.v2)
+				switch {
+				case tt.want < 0:
+					assert.Negative(t, got)
+				case tt.want > 0:
+					assert.Positive(t, got)
+				default:
+					assert.Zero(t, got)
+				}
+			},
+		)
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/KnutZuidema/golio
 
-go 1.20
+go 1.23
 
 require (
 	github.com/sirupsen/logrus v1.9.0
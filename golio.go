@@ -41,7 +41,9 @@ type Client struct {
 	apiKey     string
 	Riot       *riot.Client
 	DataDragon *datadragon.Client
-	Static     *static.Client
+	// Data Dragon client for Teamfight Tactics data, sharing the version and language of DataDragon
+	TFTDataDragon *datadragon.TFTClient
+	Static        *static.Client
 }
 
 // Option is used to alter the attributes of a client
@@ -81,6 +83,7 @@ func NewClient(apiKey string, options ...Option) *Client {
 	}
 	c.Riot = riot.NewClient(c.region, c.apiKey, c.client, c.logger)
 	c.DataDragon = datadragon.NewClient(c.client, c.region, c.logger)
+	c.TFTDataDragon = c.DataDragon.TFT()
 	c.Static = static.NewClient(c.client, c.logger)
 	return c
 }
@@ -0,0 +1,128 @@
+// Command queuegen generates the QueueID constants of the lol package from the queues provided by the static
+// package. Queues which the static data marks as deprecated are skipped. Use -input to generate the constants from
+// a local copy of queues.json instead.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/static"
+)
+
+func main() {
+	output := flag.String("output", "queue_ids.go", "file to write the constants to")
+	input := flag.String("input", "", "local copy of queues.json, the queues are fetched if empty")
+	pkg := flag.String("package", "lol", "package of the generated file")
+	flag.Parse()
+	queues, err := loadQueues(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(*pkg, queues)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func loadQueues(input string) ([]static.Queue, error) {
+	if input == "" {
+		return static.NewClient(http.DefaultClient, logrus.StandardLogger()).GetQueues()
+	}
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return nil, err
+	}
+	var queues []static.Queue
+	if err := json.Unmarshal(data, &queues); err != nil {
+		return nil, err
+	}
+	return queues, nil
+}
+
+func generate(pkg string, queues []static.Queue) ([]byte, error) {
+	queues = filterQueues(queues)
+	sort.Slice(
+		queues, func(i, j int) bool {
+			return queues[i].ID < queues[j].ID
+		},
+	)
+	names := make([]string, len(queues))
+	counts := map[string]int{}
+	for i, queue := range queues {
+		names[i] = constantName(queue)
+		counts[names[i]]++
+	}
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by queuegen; DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	fmt.Fprintf(buf, "// All queues listed by the static data which are not deprecated\nconst (\n")
+	for i, queue := range queues {
+		name := names[i]
+		if counts[name] > 1 {
+			// queues with the same description are told apart by their ID
+			name = fmt.Sprintf("%s%d", name, queue.ID)
+		}
+		fmt.Fprintf(buf, "\t// %s\n", comment(queue))
+		fmt.Fprintf(buf, "\t%s QueueID = %d\n", name, queue.ID)
+	}
+	fmt.Fprintf(buf, ")\n")
+	return format.Source(buf.Bytes())
+}
+
+// filterQueues returns the queues which are not deprecated. The static data marks deprecated queues in their
+// notes, e.g. "Deprecated in patch 7.19 in favor of queueId 430".
+func filterQueues(queues []static.Queue) []static.Queue {
+	res := make([]static.Queue, 0, len(queues))
+	for _, queue := range queues {
+		if !strings.Contains(strings.ToLower(queue.Notes), "deprecated") {
+			res = append(res, queue)
+		}
+	}
+	return res
+}
+
+// constantName returns the name of the constant for a queue derived from its description, or from its map for
+// queues without a description
+func constantName(queue static.Queue) string {
+	description := strings.TrimSuffix(strings.TrimSpace(queue.Description), " games")
+	if description == "" {
+		description = queue.Map
+	}
+	description = strings.ReplaceAll(description, "'", "")
+	name := "Queue"
+	for _, word := range strings.FieldsFunc(
+		description, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		},
+	) {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		name += string(runes)
+	}
+	return name
+}
+
+func comment(queue static.Queue) string {
+	parts := []string{queue.Map}
+	if queue.Description != "" {
+		parts = append(parts, queue.Description)
+	}
+	if queue.Notes != "" {
+		parts = append(parts, queue.Notes)
+	}
+	return strings.Join(parts, " - ")
+}
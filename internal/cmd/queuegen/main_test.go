@@ -0,0 +1,90 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/static"
+)
+
+func TestConstantName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		queue static.Queue
+		want  string
+	}{
+		{
+			queue: static.Queue{Description: "5v5 Ranked Solo games"},
+			want:  "Queue5v5RankedSolo",
+		},
+		{
+			queue: static.Queue{Description: "Co-op vs. AI Intro Bot games"},
+			want:  "QueueCoOpVsAIIntroBot",
+		},
+		{
+			queue: static.Queue{Description: "Summoner's Rift Clash games"},
+			want:  "QueueSummonersRiftClash",
+		},
+		{
+			queue: static.Queue{Map: "Custom games"},
+			want:  "QueueCustomGames",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.want, func(t *testing.T) {
+				assert.Equal(t, tt.want, constantName(tt.queue))
+			},
+		)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	got, err := generate(
+		"lol", []static.Queue{
+			{ID: 1710, Map: "Rings of Wrath", Description: "Arena"},
+			{ID: 1700, Map: "Rings of Wrath", Description: "Arena", Notes: "2v2v2v2"},
+			{ID: 420, Map: "Summoner's Rift", Description: "5v5 Ranked Solo games"},
+			{ID: 410, Map: "Summoner's Rift", Description: "5v5 Ranked Dynamic games", Notes: "Game mode deprecated in patch 7.22"},
+		},
+	)
+	require.Nil(t, err)
+	assert.Equal(
+		t, `// Code generated by queuegen; DO NOT EDIT.
+
+package lol
+
+// All queues listed by the static data which are not deprecated
+const (
+	// Summoner's Rift - 5v5 Ranked Solo games
+	Queue5v5RankedSolo QueueID = 420
+	// Rings of Wrath - Arena - 2v2v2v2
+	QueueArena1700 QueueID = 1700
+	// Rings of Wrath - Arena
+	QueueArena1710 QueueID = 1710
+)
+`, string(got),
+	)
+}
+
+func TestFilterQueues(t *testing.T) {
+	t.Parallel()
+	got := filterQueues(
+		[]static.Queue{
+			{ID: 0, Map: "Custom games"},
+			{ID: 830, Description: "Co-op vs. AI Intro Bot games", Notes: "Deprecated in favor of queueId 870"},
+			{ID: 870, Description: "Co-op vs. AI Intro Bot games"},
+			{ID: 1710, Description: "Arena", Notes: "16 player lobby"},
+		},
+	)
+	assert.Equal(
+		t, []static.Queue{
+			{ID: 0, Map: "Custom games"},
+			{ID: 870, Description: "Co-op vs. AI Intro Bot games"},
+			{ID: 1710, Description: "Arena", Notes: "16 player lobby"},
+		}, got,
+	)
+}
@@ -0,0 +1,170 @@
+// Package pagination provides an iterator over the items of paginated endpoints
+package pagination
+
+import (
+	"context"
+	"iter"
+)
+
+// Page describes the page requested by a FetchFunc
+type Page struct {
+	// Zero-based index of the page
+	Index int
+	// Index of the first item of the page
+	Start int
+	// Number of items requested. This is zero if the page size is defined by the endpoint.
+	Count int
+}
+
+// FetchFunc requests a single page
+type FetchFunc[T any] func(page Page) ([]T, error)
+
+// Options configure the iteration of a paginated endpoint. A nil *Options uses the defaults of the endpoint.
+type Options struct {
+	// Context which stops the iteration once it is done. No further pages are requested after the context is done.
+	Context context.Context
+	// Number of items requested per page. Defaults to the maximum page size of the endpoint. Ignored for endpoints
+	// with a fixed page size.
+	PageSize int
+	// Maximum number of items returned. Zero means no limit.
+	MaxItems int
+}
+
+// pageSize returns the page size of the options limited to the maximum page size of an endpoint
+func (o *Options) pageSize(limit int) int {
+	if o == nil || o.PageSize <= 0 || o.PageSize > limit {
+		return limit
+	}
+	return o.PageSize
+}
+
+// Iterator iterates over the items of a paginated endpoint, requesting pages as needed.
+// Pages are requested synchronously, so an iterator which is not used anymore does not leak any resources.
+//
+//	it := client.Match.Iterate(puuid, nil)
+//	for it.Next() {
+//		fmt.Println(it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx      context.Context
+	fetch    FetchFunc[T]
+	pageSize int
+	maxItems int
+	page     Page
+	buffer   []T
+	position int
+	returned int
+	current  T
+	err      error
+	last     bool
+	closed   bool
+}
+
+// New returns an iterator requesting pages of the given maximum size with the fetch function. A maximum size of
+// zero is used for endpoints which define the page size themselves, in which case the iteration stops at the
+// first empty page. Otherwise it also stops at the first page with fewer items than requested.
+func New[T any](fetch FetchFunc[T], maxPageSize int, options *Options) *Iterator[T] {
+	it := &Iterator[T]{
+		ctx:   context.Background(),
+		fetch: fetch,
+	}
+	if maxPageSize > 0 {
+		it.pageSize = options.pageSize(maxPageSize)
+	}
+	if options != nil {
+		if options.Context != nil {
+			it.ctx = options.Context
+		}
+		it.maxItems = options.MaxItems
+	}
+	return it
+}
+
+// Next advances the iterator to the next item and returns whether there is one. The item is available through
+// Value. If Next returns false Err should be checked.
+func (it *Iterator[T]) Next() bool {
+	if it.closed || (it.maxItems > 0 && it.returned >= it.maxItems) {
+		return false
+	}
+	for it.position >= len(it.buffer) {
+		if it.last {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			it.closed = true
+			return false
+		}
+		if err := it.nextPage(); err != nil {
+			it.err = err
+			it.closed = true
+			return false
+		}
+	}
+	it.current = it.buffer[it.position]
+	it.position++
+	it.returned++
+	return true
+}
+
+func (it *Iterator[T]) nextPage() error {
+	count := it.pageSize
+	if count > 0 && it.maxItems > 0 {
+		count = min(count, it.maxItems-it.returned)
+	}
+	it.page.Count = count
+	items, err := it.fetch(it.page)
+	if err != nil {
+		return err
+	}
+	it.buffer = items
+	it.position = 0
+	it.last = len(items) == 0 || (count > 0 && len(items) < count)
+	it.page.Index++
+	it.page.Start += len(items)
+	return nil
+}
+
+// Value returns the current item
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Close stops the iteration. No further pages are requested.
+func (it *Iterator[T]) Close() {
+	it.closed = true
+}
+
+// All returns the remaining items as an iter.Seq2. If the iteration fails the error is yielded as the last value
+// together with the zero value of T. Breaking out of the loop closes the iterator.
+func (it *Iterator[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for it.Next() {
+			if !yield(it.Value(), nil) {
+				it.Close()
+				return
+			}
+		}
+		if it.err != nil {
+			var zero T
+			yield(zero, it.err)
+		}
+	}
+}
+
+// Collect returns all remaining items
+func (it *Iterator[T]) Collect() ([]T, error) {
+	var res []T
+	for it.Next() {
+		res = append(res, it.Value())
+	}
+	return res, it.Err()
+}
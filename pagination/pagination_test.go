@@ -0,0 +1,146 @@
+package pagination
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// numbers returns a fetch function serving the numbers from 0 to total-1 and records the requested pages
+func numbers(total int, pages *[]Page) FetchFunc[int] {
+	return func(page Page) ([]int, error) {
+		*pages = append(*pages, page)
+		count := page.Count
+		if count == 0 {
+			count = 3
+		}
+		var res []int
+		for i := page.Start; i < total && i < page.Start+count; i++ {
+			res = append(res, i)
+		}
+		return res, nil
+	}
+}
+
+func TestIterator(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		total       int
+		maxPageSize int
+		options     *Options
+		want        []int
+		wantPages   []Page
+	}{
+		{
+			name:        "short last page",
+			total:       5,
+			maxPageSize: 2,
+			want:        []int{0, 1, 2, 3, 4},
+			wantPages:   []Page{{0, 0, 2}, {1, 2, 2}, {2, 4, 2}},
+		},
+		{
+			name:        "empty last page",
+			total:       4,
+			maxPageSize: 2,
+			want:        []int{0, 1, 2, 3},
+			wantPages:   []Page{{0, 0, 2}, {1, 2, 2}, {2, 4, 2}},
+		},
+		{
+			name:        "page size",
+			total:       4,
+			maxPageSize: 100,
+			options:     &Options{PageSize: 3},
+			want:        []int{0, 1, 2, 3},
+			wantPages:   []Page{{0, 0, 3}, {1, 3, 3}},
+		},
+		{
+			name:        "page size above maximum",
+			total:       3,
+			maxPageSize: 2,
+			options:     &Options{PageSize: 10},
+			want:        []int{0, 1, 2},
+			wantPages:   []Page{{0, 0, 2}, {1, 2, 2}},
+		},
+		{
+			name:        "max items",
+			total:       10,
+			maxPageSize: 4,
+			options:     &Options{MaxItems: 5},
+			want:        []int{0, 1, 2, 3, 4},
+			wantPages:   []Page{{0, 0, 4}, {1, 4, 1}},
+		},
+		{
+			name:      "fixed page size",
+			total:     5,
+			want:      []int{0, 1, 2, 3, 4},
+			wantPages: []Page{{0, 0, 0}, {1, 3, 0}, {2, 5, 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var pages []Page
+				got, err := New(numbers(tt.total, &pages), tt.maxPageSize, tt.options).Collect()
+				require.Nil(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantPages, pages)
+			},
+		)
+	}
+}
+
+func TestIterator_Error(t *testing.T) {
+	t.Parallel()
+	wantErr := errors.New("error")
+	it := New(
+		func(page Page) ([]int, error) {
+			if page.Index > 0 {
+				return nil, wantErr
+			}
+			return []int{1, 2}, nil
+		}, 2, nil,
+	)
+	var got []int
+	var gotErr error
+	for v, err := range it.All() {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{1, 2}, got)
+	assert.Equal(t, wantErr, gotErr)
+	assert.False(t, it.Next())
+}
+
+func TestIterator_Break(t *testing.T) {
+	t.Parallel()
+	var pages []Page
+	it := New(numbers(10, &pages), 2, nil)
+	for v := range it.All() {
+		if v == 2 {
+			break
+		}
+	}
+	assert.False(t, it.Next(), "breaking out of the loop should close the iterator")
+	assert.Len(t, pages, 2)
+	assert.Nil(t, it.Err())
+}
+
+func TestIterator_Context(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	var pages []Page
+	it := New(numbers(10, &pages), 2, &Options{Context: ctx})
+	require.True(t, it.Next())
+	require.True(t, it.Next())
+	cancel()
+	assert.False(t, it.Next())
+	assert.Equal(t, context.Canceled, it.Err())
+	assert.Len(t, pages, 1)
+}
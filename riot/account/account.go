@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -42,6 +43,24 @@ func (ac *Client) GetByRiotID(gameName, tagLine string) (*Account, error) {
 	return &account, nil
 }
 
+// GetByAccessToken returns the account the given access token was issued for
+func (ac *Client) GetByAccessToken(authorization string) (*Account, error) {
+	logger := ac.logger().WithField("method", "GetByAccessToken")
+	var account Account
+	c := *ac.c
+	c.Region = api.Region(api.RegionToRoute[c.Region])
+
+	if err := c.GetInto(
+		endpointGetByMe,
+		&account,
+		internal.WithHeader("Authorization", authorization),
+	); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return &account, nil
+}
+
 func (ac *Client) logger() log.FieldLogger {
 	return ac.c.Logger().WithField("category", "account")
 }
@@ -79,3 +79,43 @@ func TestAccountClient_GetByRiotID(t *testing.T) {
 		)
 	}
 }
+
+func TestAccountClient_GetByAccessToken(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    *Account
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: &Account{Puuid: "puuid"},
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if r.Header.Get("Authorization") != "Bearer token" {
+						return mock.NewStatusMockDoer(http.StatusUnauthorized).Do(r)
+					}
+					return mock.NewJSONMockDoer(Account{Puuid: "puuid"}, 200).Do(r)
+				},
+			},
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&Client{c: client}).GetByAccessToken("Bearer token")
+				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
@@ -6,4 +6,5 @@ const (
 	endpointAccountsBase = endpointAccountBase + "/accounts"
 	endpointGetByPUUID   = endpointAccountsBase + "/by-puuid/%s"
 	endpointGetByRiotID  = endpointAccountsBase + "/by-riot-id/%s/%s"
+	endpointGetByMe      = endpointAccountsBase + "/me"
 )
@@ -0,0 +1,292 @@
+// Package crawler provides a Crawler which fetches the full match history of a set of players, optionally
+// snowballing to the players they played with. The progress is checkpointed through a Store, so an interrupted
+// crawl can be resumed.
+package crawler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/pagination"
+)
+
+const (
+	defaultWorkers         = 4
+	defaultCheckpointEvery = 100
+)
+
+// HandleFunc processes a fetched match. It is never called concurrently. If an error is returned the crawl stops.
+type HandleFunc[M any] func(id string, match M) error
+
+// Config configures a Crawler. The zero value is a valid configuration.
+type Config struct {
+	// Number of matches fetched concurrently. Defaults to 4.
+	Workers int
+	// Maximum number of match requests per second sent by the crawler. Listing the matches of a player takes one
+	// request per 100 matches and is not paced. Zero means no limit besides the rate limit handling of the client.
+	RequestsPerSecond float64
+	// Crawl the match histories of all players of the fetched matches as well
+	Snowball bool
+	// Maximum number of players crawled, including the initial players. Zero means no limit.
+	MaxPlayers int
+	// Maximum number of matches listed per player. Zero means the full history.
+	MaxMatchesPerPlayer int
+	// Store for the checkpoints. Defaults to a MemoryStore.
+	Store Store
+	// Number of fetched matches after which a checkpoint is saved. A checkpoint is also saved after every player.
+	// Defaults to 100.
+	CheckpointEvery int
+	Logger          log.FieldLogger
+}
+
+// Crawler fetches the match history of players from a Source
+type Crawler[M any] struct {
+	source Source[M]
+	config Config
+}
+
+// New returns a new Crawler fetching matches from the source
+func New[M any](source Source[M], config Config) *Crawler[M] {
+	if config.Workers <= 0 {
+		config.Workers = defaultWorkers
+	}
+	if config.Store == nil {
+		config.Store = &MemoryStore{}
+	}
+	if config.CheckpointEvery <= 0 {
+		config.CheckpointEvery = defaultCheckpointEvery
+	}
+	if config.Logger == nil {
+		config.Logger = log.StandardLogger()
+	}
+	return &Crawler[M]{
+		source: source,
+		config: config,
+	}
+}
+
+// state is the progress of a running crawl. It is only accessed by the goroutine running the crawl.
+type state struct {
+	checkpoint  *Checkpoint
+	players     map[string]bool
+	fetched     map[string]bool
+	sinceSaving int
+}
+
+// Run crawls the match histories of the given players and the players discovered through snowballing, calling
+// handle for every fetched match. Every match is fetched once, even if multiple players played in it.
+// If a checkpoint was saved by a previous run the crawl is resumed. Run returns when all players were crawled,
+// the context is done or an error occurred. Matches which are not found are skipped.
+func (c *Crawler[M]) Run(ctx context.Context, puuids []string, handle HandleFunc[M]) error {
+	logger := c.logger().WithField("method", "Run")
+	checkpoint, err := c.config.Store.Load()
+	if err != nil {
+		logger.Debug(err)
+		return err
+	}
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{}
+	}
+	s := &state{
+		checkpoint: checkpoint,
+		players:    map[string]bool{},
+		fetched:    map[string]bool{},
+	}
+	for _, puuid := range checkpoint.Players {
+		s.players[puuid] = true
+	}
+	for _, id := range checkpoint.Fetched {
+		s.fetched[id] = true
+	}
+	c.addPlayers(s, puuids)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	pace := c.pacer(ctx)
+	for checkpoint.Next < len(checkpoint.Players) {
+		puuid := checkpoint.Players[checkpoint.Next]
+		if err := c.crawlPlayer(ctx, s, puuid, pace, handle); err != nil {
+			logger.Debug(err)
+			if saveErr := c.config.Store.Save(checkpoint); saveErr != nil {
+				logger.Debug(saveErr)
+			}
+			return err
+		}
+		checkpoint.Next++
+		if err := c.save(s); err != nil {
+			logger.Debug(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Crawler[M]) crawlPlayer(
+	ctx context.Context, s *state, puuid string, pace func() error, handle HandleFunc[M],
+) error {
+	var ids []string
+	seen := map[string]bool{}
+	it := c.source.MatchIDs(
+		puuid, &pagination.Options{
+			Context:  ctx,
+			MaxItems: c.config.MaxMatchesPerPlayer,
+		},
+	)
+	for it.Next() {
+		id := it.Value()
+		if !s.fetched[id] && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	// matches which are not found are marked as fetched as well, so they are not requested again after resuming
+	done := func(id string) error {
+		s.fetched[id] = true
+		s.checkpoint.Fetched = append(s.checkpoint.Fetched, id)
+		s.sinceSaving++
+		if s.sinceSaving >= c.config.CheckpointEvery {
+			return c.save(s)
+		}
+		return nil
+	}
+	return c.fetch(
+		ctx, ids, pace, func(id string, match M) error {
+			if err := handle(id, match); err != nil {
+				return err
+			}
+			if c.config.Snowball {
+				c.addPlayers(s, c.source.Participants(match))
+			}
+			return done(id)
+		}, done,
+	)
+}
+
+type result[M any] struct {
+	id    string
+	match M
+	err   error
+}
+
+// fetch fetches the matches with the configured number of workers and calls handle for every match, or notFound for
+// every match which does not exist, from the calling goroutine
+func (c *Crawler[M]) fetch(
+	ctx context.Context, ids []string, pace func() error, handle HandleFunc[M], notFound func(id string) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	jobs := make(chan string)
+	results := make(chan result[M])
+	var wg sync.WaitGroup
+	for i := 0; i < c.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				res := result[M]{id: id}
+				if res.err = pace(); res.err == nil {
+					res.match, res.err = c.source.Match(id)
+				}
+				select {
+				case results <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for _, id := range ids {
+			select {
+			case jobs <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	for res := range results {
+		err := res.err
+		switch {
+		case errors.Is(err, api.ErrNotFound):
+			err = notFound(res.id)
+		case err == nil:
+			err = handle(res.id, res.match)
+		}
+		if err != nil {
+			cancel()
+			// drain the results so the workers can exit
+			for range results {
+			}
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// pacer returns a function which blocks until the next request may be sent
+func (c *Crawler[M]) pacer(ctx context.Context) func() error {
+	if c.config.RequestsPerSecond <= 0 {
+		return ctx.Err
+	}
+	interval := time.Duration(float64(time.Second) / c.config.RequestsPerSecond)
+	var mu sync.Mutex
+	var next time.Time
+	return func() error {
+		mu.Lock()
+		now := time.Now()
+		if next.Before(now) {
+			next = now
+		}
+		wait := next.Sub(now)
+		next = next.Add(interval)
+		mu.Unlock()
+		if wait == 0 {
+			return ctx.Err()
+		}
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (c *Crawler[M]) addPlayers(s *state, puuids []string) {
+	for _, puuid := range puuids {
+		if s.players[puuid] {
+			continue
+		}
+		if c.config.MaxPlayers > 0 && len(s.checkpoint.Players) >= c.config.MaxPlayers {
+			return
+		}
+		s.players[puuid] = true
+		s.checkpoint.Players = append(s.checkpoint.Players, puuid)
+	}
+}
+
+func (c *Crawler[M]) save(s *state) error {
+	s.sinceSaving = 0
+	return c.config.Store.Save(s.checkpoint)
+}
+
+func (c *Crawler[M]) logger() log.FieldLogger {
+	return c.config.Logger.WithField("category", "crawler")
+}
@@ -0,0 +1,272 @@
+package crawler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/pagination"
+	"github.com/KnutZuidema/golio/riot/lol"
+	"github.com/KnutZuidema/golio/riot/tft"
+)
+
+// fakeSource serves matches which are identified by their comma separated participants
+type fakeSource struct {
+	mu        sync.Mutex
+	histories map[string][]string
+	fail      map[string]error
+	requests  map[string]int
+}
+
+func newFakeSource(histories map[string][]string) *fakeSource {
+	return &fakeSource{
+		histories: histories,
+		fail:      map[string]error{},
+		requests:  map[string]int{},
+	}
+}
+
+func (s *fakeSource) MatchIDs(puuid string, options *pagination.Options) *pagination.Iterator[string] {
+	return pagination.New(
+		func(page pagination.Page) ([]string, error) {
+			history := s.histories[puuid]
+			if page.Start >= len(history) {
+				return nil, nil
+			}
+			return history[page.Start:min(len(history), page.Start+page.Count)], nil
+		}, 100, options,
+	)
+}
+
+func (s *fakeSource) Match(id string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests[id]++
+	if err := s.fail[id]; err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (s *fakeSource) Participants(match string) []string {
+	return strings.Split(match, ",")
+}
+
+func (s *fakeSource) setFail(id string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		delete(s.fail, id)
+	} else {
+		s.fail[id] = err
+	}
+}
+
+func collect(matches *[]string) HandleFunc[string] {
+	return func(id string, match string) error {
+		*matches = append(*matches, id)
+		return nil
+	}
+}
+
+func TestCrawler_Run(t *testing.T) {
+	t.Parallel()
+	histories := map[string][]string{
+		"a": {"a,b", "a,c", "a,b"},
+		"b": {"a,b", "b,d"},
+		"c": {"a,c"},
+		"d": {"b,d", "d,e"},
+	}
+	tests := []struct {
+		name        string
+		config      Config
+		want        []string
+		wantPlayers []string
+	}{
+		{
+			name:        "initial players",
+			want:        []string{"a,b", "a,c", "b,d"},
+			wantPlayers: []string{"a", "b"},
+		},
+		{
+			name:        "snowball",
+			config:      Config{Snowball: true},
+			want:        []string{"a,b", "a,c", "b,d", "d,e"},
+			wantPlayers: []string{"a", "b", "c", "d", "e"},
+		},
+		{
+			name:        "max players",
+			config:      Config{Snowball: true, MaxPlayers: 3},
+			want:        []string{"a,b", "a,c", "b,d"},
+			wantPlayers: []string{"a", "b", "c"},
+		},
+		{
+			name:        "max matches per player",
+			config:      Config{MaxMatchesPerPlayer: 1, Workers: 1, RequestsPerSecond: 1000},
+			want:        []string{"a,b"},
+			wantPlayers: []string{"a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				source := newFakeSource(histories)
+				store := &MemoryStore{}
+				tt.config.Store = store
+				var got []string
+				err := New[string](source, tt.config).Run(context.Background(), []string{"a", "b"}, collect(&got))
+				require.Nil(t, err)
+				sort.Strings(got)
+				assert.Equal(t, tt.want, got)
+				for id, count := range source.requests {
+					assert.Equal(t, 1, count, "match %s should be fetched once", id)
+				}
+				checkpoint, err := store.Load()
+				require.Nil(t, err)
+				assert.Equal(t, tt.wantPlayers, checkpoint.Players)
+				assert.Equal(t, len(tt.wantPlayers), checkpoint.Next)
+			},
+		)
+	}
+}
+
+func TestCrawler_Resume(t *testing.T) {
+	t.Parallel()
+	source := newFakeSource(
+		map[string][]string{
+			"a": {"a,1", "a,2"},
+			"b": {"b,1", "b,2", "b,3"},
+		},
+	)
+	wantErr := errors.New("error")
+	source.setFail("b,2", wantErr)
+	store := NewFileStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	crawler := New[string](source, Config{Store: store, Workers: 1, CheckpointEvery: 1})
+	var got []string
+	err := crawler.Run(context.Background(), []string{"a", "b"}, collect(&got))
+	assert.Equal(t, wantErr, err)
+
+	checkpoint, err := store.Load()
+	require.Nil(t, err)
+	assert.Equal(t, 1, checkpoint.Next)
+	assert.Contains(t, checkpoint.Fetched, "a,1")
+	assert.NotContains(t, checkpoint.Fetched, "b,2")
+
+	source.setFail("b,2", nil)
+	err = crawler.Run(context.Background(), nil, collect(&got))
+	require.Nil(t, err)
+	sort.Strings(got)
+	assert.Equal(t, []string{"a,1", "a,2", "b,1", "b,2", "b,3"}, got)
+	assert.Equal(t, 1, source.requests["a,1"], "matches of finished players should not be fetched again")
+}
+
+func TestCrawler_NotFound(t *testing.T) {
+	t.Parallel()
+	source := newFakeSource(map[string][]string{"a": {"a,1", "a,2"}})
+	source.setFail("a,1", api.ErrNotFound)
+	var got []string
+	store := &MemoryStore{}
+	err := New[string](source, Config{Store: store}).Run(context.Background(), []string{"a"}, collect(&got))
+	require.Nil(t, err)
+	assert.Equal(t, []string{"a,2"}, got)
+	checkpoint, err := store.Load()
+	require.Nil(t, err)
+	assert.ElementsMatch(t, []string{"a,1", "a,2"}, checkpoint.Fetched, "missing matches should be checkpointed")
+}
+
+func TestCrawler_HandleError(t *testing.T) {
+	t.Parallel()
+	source := newFakeSource(map[string][]string{"a": {"a,1", "a,2", "a,3"}})
+	wantErr := errors.New("error")
+	err := New[string](source, Config{Workers: 2}).Run(
+		context.Background(), []string{"a"}, func(id string, match string) error {
+			return wantErr
+		},
+	)
+	assert.Equal(t, wantErr, err)
+}
+
+func TestCrawler_Canceled(t *testing.T) {
+	t.Parallel()
+	source := newFakeSource(map[string][]string{"a": {"a,1"}})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := New[string](source, Config{}).Run(ctx, []string{"a"}, collect(new([]string)))
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestFileStore(t *testing.T) {
+	t.Parallel()
+	store := NewFileStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+	got, err := store.Load()
+	require.Nil(t, err)
+	assert.Nil(t, got)
+	want := &Checkpoint{Players: []string{"a"}, Next: 1, Fetched: []string{"1"}}
+	require.Nil(t, store.Save(want))
+	got, err = store.Load()
+	require.Nil(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestLoLSource(t *testing.T) {
+	t.Parallel()
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(r.URL.Path, "/ids") {
+				return mock.NewJSONMockDoer([]string{"EUW1_1"}, 200).Do(r)
+			}
+			return mock.NewJSONMockDoer(
+				lol.Match{Metadata: &lol.MatchMetadata{MatchID: "EUW1_1", Participants: []string{"a", "b"}}}, 200,
+			).Do(r)
+		},
+	}
+	client := lol.NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+	var got []*lol.Match
+	err := New[*lol.Match](&LoLSource{Client: client}, Config{Snowball: true}).Run(
+		context.Background(), []string{"a"}, func(id string, match *lol.Match) error {
+			got = append(got, match)
+			return nil
+		},
+	)
+	require.Nil(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "EUW1_1", got[0].Metadata.MatchID)
+}
+
+func TestTFTSource(t *testing.T) {
+	t.Parallel()
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(r.URL.Path, "/ids") {
+				return mock.NewJSONMockDoer([]string{"EUW1_1", "EUW1_2"}, 200).Do(r)
+			}
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			return mock.NewJSONMockDoer(
+				tft.Match{Metadata: tft.Metadata{MatchID: id, Participants: []string{"a", "b"}}}, 200,
+			).Do(r)
+		},
+	}
+	client := tft.NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+	var got []string
+	err := New[*tft.Match](&TFTSource{Client: client}, Config{Snowball: true}).Run(
+		context.Background(), []string{"a"}, func(id string, match *tft.Match) error {
+			got = append(got, match.Metadata.MatchID)
+			return nil
+		},
+	)
+	require.Nil(t, err)
+	sort.Strings(got)
+	assert.Equal(t, []string{"EUW1_1", "EUW1_2"}, got)
+}
@@ -0,0 +1,65 @@
+package crawler
+
+import (
+	"github.com/KnutZuidema/golio/pagination"
+	"github.com/KnutZuidema/golio/riot/lol"
+	"github.com/KnutZuidema/golio/riot/tft"
+)
+
+// Source provides the match history of players for a game
+type Source[M any] interface {
+	// MatchIDs returns an iterator over the match IDs of a player
+	MatchIDs(puuid string, options *pagination.Options) *pagination.Iterator[string]
+	// Match returns the match with the given ID
+	Match(id string) (M, error)
+	// Participants returns the PUUIDs of the players of a match
+	Participants(match M) []string
+}
+
+// LoLSource is a Source for League of Legends matches
+type LoLSource struct {
+	Client *lol.Client
+	// Optional filter for the listed matches
+	Options *lol.MatchListOptions
+}
+
+// MatchIDs implements Source
+func (s *LoLSource) MatchIDs(puuid string, options *pagination.Options) *pagination.Iterator[string] {
+	if s.Options == nil {
+		return s.Client.Match.Iterate(puuid, options)
+	}
+	return s.Client.Match.Iterate(puuid, options, s.Options)
+}
+
+// Match implements Source
+func (s *LoLSource) Match(id string) (*lol.Match, error) {
+	return s.Client.Match.Get(id)
+}
+
+// Participants implements Source
+func (s *LoLSource) Participants(match *lol.Match) []string {
+	if match.Metadata == nil {
+		return nil
+	}
+	return match.Metadata.Participants
+}
+
+// TFTSource is a Source for Teamfight Tactics matches
+type TFTSource struct {
+	Client *tft.Client
+}
+
+// MatchIDs implements Source
+func (s *TFTSource) MatchIDs(puuid string, options *pagination.Options) *pagination.Iterator[string] {
+	return s.Client.Match.IterateMatchesByPUUID(puuid, options)
+}
+
+// Match implements Source
+func (s *TFTSource) Match(id string) (*tft.Match, error) {
+	return s.Client.Match.GetMatchByMatchID(id)
+}
+
+// Participants implements Source
+func (s *TFTSource) Participants(match *tft.Match) []string {
+	return match.Metadata.Participants
+}
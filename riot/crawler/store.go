@@ -0,0 +1,106 @@
+package crawler
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpoint is the progress of a crawl
+type Checkpoint struct {
+	// PUUIDs of all players to crawl in the order they were discovered
+	Players []string `json:"players"`
+	// Index of the next player whose match history has not been completely crawled
+	Next int `json:"next"`
+	// IDs of all matches which were fetched, including matches which were not found
+	Fetched []string `json:"fetched"`
+}
+
+// Store persists checkpoints so an interrupted crawl can be resumed
+type Store interface {
+	// Load returns the last saved checkpoint or nil if none was saved yet
+	Load() (*Checkpoint, error)
+	// Save stores the checkpoint, replacing the previous one
+	Save(checkpoint *Checkpoint) error
+}
+
+// MemoryStore is a Store which keeps the checkpoint in memory
+type MemoryStore struct {
+	mu         sync.Mutex
+	checkpoint *Checkpoint
+}
+
+// Load implements Store
+func (s *MemoryStore) Load() (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.checkpoint == nil {
+		return nil, nil
+	}
+	return s.checkpoint.copy(), nil
+}
+
+// Save implements Store
+func (s *MemoryStore) Save(checkpoint *Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoint = checkpoint.copy()
+	return nil
+}
+
+// FileStore is a Store which keeps the checkpoint in a JSON file
+type FileStore struct {
+	Path string
+}
+
+// NewFileStore returns a new FileStore using the file at the given path
+func NewFileStore(path string) *FileStore {
+	return &FileStore{Path: path}
+}
+
+// Load implements Store
+func (s *FileStore) Load() (*Checkpoint, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+// Save implements Store. The file is replaced atomically so a crash while saving does not corrupt the checkpoint.
+func (s *FileStore) Save(checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+func (c *Checkpoint) copy() *Checkpoint {
+	return &Checkpoint{
+		Players: append([]string(nil), c.Players...),
+		Next:    c.Next,
+		Fetched: append([]string(nil), c.Fetched...),
+	}
+}
@@ -6,8 +6,11 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/pagination"
 )
 
+const maxChallengeLeaderboardPage = 200
+
 // ChallengesClient provides methods for the challenges endpoints of the League of Legends API.
 type ChallengesClient struct {
 	c *internal.Client
@@ -75,6 +78,22 @@ func (cc *ChallengesClient) GetLeaderBoardByChallengeIDAndLevel(
 	return apexPlayerInfo, nil
 }
 
+// IterateLeaderboard returns an iterator over the top players of a challenge for the given level.
+// The endpoint only supports a limit, so every page requests all players up to the end of the page.
+func (cc *ChallengesClient) IterateLeaderboard(
+	challengeID int64, tier tier, options *pagination.Options,
+) *pagination.Iterator[*ApexPlayerInfo] {
+	return pagination.New(
+		func(page pagination.Page) ([]*ApexPlayerInfo, error) {
+			players, err := cc.GetLeaderBoardByChallengeIDAndLevel(challengeID, tier, int32(page.Start+page.Count))
+			if err != nil || len(players) <= page.Start {
+				return nil, err
+			}
+			return players[page.Start:], nil
+		}, maxChallengeLeaderboardPage, options,
+	)
+}
+
 // GetPercentilesByChallengeID returns map of level to percentiles of players who have achieved it for a challenge
 func (cc *ChallengesClient) GetPercentilesByChallengeID(challengeID int64) (Percentiles, error) {
 	logger := cc.logger().WithField("method", "GetPercentilesByChallengeID")
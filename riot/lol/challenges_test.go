@@ -3,6 +3,7 @@ package lol
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/sirupsen/logrus"
@@ -12,6 +13,7 @@ import (
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/pagination"
 )
 
 func TestChallengesClient_GetConfig(t *testing.T) {
@@ -47,6 +49,30 @@ func TestChallengesClient_GetConfig(t *testing.T) {
 	}
 }
 
+func TestChallengesClient_IterateLeaderboard(t *testing.T) {
+	t.Parallel()
+	var limits []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			limits = append(limits, r.URL.Query().Get("limit"))
+			players := []*ApexPlayerInfo{}
+			for i := 0; i < limit && i < 5; i++ {
+				players = append(players, &ApexPlayerInfo{Position: int32(i)})
+			}
+			return mock.NewJSONMockDoer(players, 200).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	got, err := (&ChallengesClient{c: client}).IterateLeaderboard(
+		1, TierMaster, &pagination.Options{PageSize: 2},
+	).Collect()
+	require.Nil(t, err)
+	require.Len(t, got, 5)
+	assert.Equal(t, int32(4), got[4].Position)
+	assert.Equal(t, []string{"2", "4", "6"}, limits)
+}
+
 func TestChallengesClient_GetPercentiles(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -25,23 +25,25 @@ const (
 	endpointGetLeaguesBySummoner               = endpointLeagueBase + "/entries/by-summoner/%s"
 	endpointGetLeaguesByPuuid                  = endpointLeagueBase + "/entries/by-puuid/%s"
 	endpointGetLeagues                         = endpointLeagueBase + "/entries/%s/%s/%s"
+	endpointGetLeaguesPage                     = endpointGetLeagues + "?page=%d"
 	endpointGetLeague                          = endpointLeagueBase + "/leagues/%s"
 	endpointStatusBase                         = endpointBase + "/status/v3"
 	endpointGetStatus                          = endpointStatusBase + "/shard-data"
 	endpointMatchBase                          = endpointBase + "/match/v5"
 	endpointGetMatchIDsBase                    = endpointMatchBase + "/matches/by-puuid"
-	endpointGetMatchIDs                        = endpointGetMatchIDsBase + "/%s/ids?start=%d&count=%d"
+	endpointGetMatchIDs                        = endpointGetMatchIDsBase + "/%s/ids"
 	endpointGetMatch                           = endpointMatchBase + "/matches/%s"
 	endpointGetMatchTimeline                   = endpointMatchBase + "/matches/%s/timeline"
 	endpointSummonerBase                       = endpointBase + "/summoner/v4"
 	endpointGetSummonerBySummonerID            = endpointSummonerBase + "/summoners/%s"
 	endpointGetSummonerBy                      = endpointSummonerBase + "/summoners/by-%s/%s"
 	endpointSpectatorBase                      = endpointBase + "/spectator/v5"
-	endpointGetCurrentGame                     = endpointSpectatorBase + "/active-games/by-summoner/%s"
+	endpointGetCurrentGame                     = endpointSpectatorBase + "/active-games/by-puuid/%s"
 	endpointGetFeaturedGames                   = endpointSpectatorBase + "/featured-games"
 	endpointTournamentStubBase                 = endpointBase + "/tournament-stub/v5"
 	endpointCreateStubTournamentCodes          = endpointTournamentStubBase + "/codes?count=%d&tournamentId=%d"
 	endpointGetStubLobbyEvents                 = endpointTournamentStubBase + "/lobby-events/by-code/%s"
+	endpointGetStubTournament                  = endpointTournamentStubBase + "/codes/%s"
 	endpointCreateStubTournamentProvider       = endpointTournamentStubBase + "/providers"
 	endpointCreateStubTournament               = endpointTournamentStubBase + "/tournaments"
 	endpointTournamentBase                     = endpointBase + "/tournament/v5"
@@ -51,6 +53,7 @@ const (
 	endpointCreateTournament                   = endpointTournamentBase + "/tournaments"
 	endpointGetTournament                      = endpointTournamentBase + "/codes/%s"
 	endpointUpdateTournament                   = endpointTournamentBase + "/codes/%s"
+	endpointGetTournamentGames                 = endpointTournamentBase + "/games/by-code/%s"
 	endpointGetThirdPartyCode                  = endpointPlatformBase + "/third-party-code/by-summoner/%s"
 )
 
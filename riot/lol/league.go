@@ -6,6 +6,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/pagination"
 )
 
 // LeagueClient provides methods for league endpoints of the League of Legends API.
@@ -79,6 +80,30 @@ func (l *LeagueClient) ListPlayers(queue queue, tier tier, division division) ([
 	return leagues, nil
 }
 
+// ListPlayersPage returns a single page of the players with a league specified by its queue, tier and division.
+// Pages start at 1, ListPlayers returns the first page.
+func (l *LeagueClient) ListPlayersPage(queue queue, tier tier, division division, page int) ([]*LeagueItem, error) {
+	logger := l.logger().WithField("method", "ListPlayersPage")
+	var leagues []*LeagueItem
+	if err := l.c.GetInto(fmt.Sprintf(endpointGetLeaguesPage, queue, tier, division, page), &leagues); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return leagues, nil
+}
+
+// IteratePlayers returns an iterator over all pages of the players with a league specified by its queue, tier and
+// division. The page size is defined by the API, so the PageSize option is ignored.
+func (l *LeagueClient) IteratePlayers(
+	queue queue, tier tier, division division, options *pagination.Options,
+) *pagination.Iterator[*LeagueItem] {
+	return pagination.New(
+		func(page pagination.Page) ([]*LeagueItem, error) {
+			return l.ListPlayersPage(queue, tier, division, page.Index+1)
+		}, 0, options,
+	)
+}
+
 // Get returns a ranked league with the specified ID
 func (l *LeagueClient) Get(leagueID string) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "Get")
@@ -146,6 +146,26 @@ func TestLeagueClient_ListPlayers(t *testing.T) {
 	}
 }
 
+func TestLeagueClient_IteratePlayers(t *testing.T) {
+	t.Parallel()
+	var pages []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			page := r.URL.Query().Get("page")
+			pages = append(pages, page)
+			if page == "3" {
+				return mock.NewJSONMockDoer([]*LeagueItem{}, 200).Do(r)
+			}
+			return mock.NewJSONMockDoer([]*LeagueItem{{SummonerID: page}}, 200).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	got, err := (&LeagueClient{c: client}).IteratePlayers(QueueRankedSolo, TierGold, DivisionOne, nil).Collect()
+	require.Nil(t, err)
+	assert.Equal(t, []*LeagueItem{{SummonerID: "1"}, {SummonerID: "2"}}, got)
+	assert.Equal(t, []string{"1", "2", "3"}, pages)
+}
+
 func TestLeagueClient_ListBySummoner(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
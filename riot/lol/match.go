@@ -1,51 +1,148 @@
 package lol
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"strconv"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/pagination"
 )
 
+const maxMatchIDs = 100
+
 // MatchClient provides methods for the match endpoints of the League of Legends API.
 type MatchClient struct {
 	c *internal.Client
 }
 
-// MatchListOptions providing additional options for List
+// MatchListStartTimeEpoch is the date from which on the matchlist stores timestamps. Matches played before are not
+// included in the results if a start time is set.
+var MatchListStartTimeEpoch = time.Date(2021, time.June, 16, 0, 0, 0, 0, time.UTC)
+
+// All errors returned by the validation of MatchListOptions
+var (
+	ErrInvalidMatchStart    = errors.New("start must not be negative")
+	ErrInvalidMatchCount    = errors.New("count must be between 0 and 100")
+	ErrInvalidMatchType     = errors.New("invalid match type")
+	ErrInvalidTimeRange     = errors.New("end time must not be before start time")
+	ErrStartTimeBeforeEpoch = errors.New("start time must not be before June 16th, 2021")
+)
+
+// QueueID is the ID of a queue as listed by static.Client.GetQueues
+type QueueID int
+
+// queues.json is a copy of the queues of the static data. Replace it with the current queues and run go generate to
+// update the QueueID constants.
+//go:generate go run ../../internal/cmd/queuegen -input queues.json -output queue_ids.go
+
+// MatchType is the type of a match
+type MatchType string
+
+// All types of matches
+const (
+	MatchTypeRanked   MatchType = "ranked"
+	MatchTypeNormal   MatchType = "normal"
+	MatchTypeTourney  MatchType = "tourney"
+	MatchTypeTutorial MatchType = "tutorial"
+)
+
+// MatchTypes is a list of all available match types
+var MatchTypes = []MatchType{
+	MatchTypeRanked,
+	MatchTypeNormal,
+	MatchTypeTourney,
+	MatchTypeTutorial,
+}
+
+// Valid returns whether the match type is one of the known match types
+func (t MatchType) Valid() bool {
+	for _, matchType := range MatchTypes {
+		if t == matchType {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchListOptions providing additional options for List. They are also used by the TFT match list.
 type MatchListOptions struct {
 	// Filter the list of match ids by a specific queue id. This filter is mutually inclusive
 	// of the type filter meaning any match ids returned must match both the queue and type filters.
-	Queue *int
+	Queue *QueueID
 	// Filter the list of match ids by the type of match. This filter is mutually inclusive of
 	// the queue filter meaning any match ids returned must match both the queue and type
-	// filters. (see static.GameType.Type).
-	Type string
+	// filters.
+	Type MatchType
+
+	// Start index of the returned match ids. Defaults to 0.
+	Start int
+	// Number of match ids to return, between 0 and 100. Defaults to 20 if zero.
+	Count int
 
 	// Filter the list of matches by start and/or end time. The matchlist started storing timestamps
 	// on June 16th, 2021. Any matches played before June 16th, 2021 won't be included in the results
-	// if the StartTime filter is set.
+	// if the StartTime filter is set, so a StartTime before that date is rejected.
 	StartTime, EndTime time.Time
 }
 
-func (mo *MatchListOptions) buildParam() string {
-	var param string
+// Validate checks the options for values which are rejected by the API or lead to incomplete results
+func (mo *MatchListOptions) Validate() error {
+	if mo.Start < 0 {
+		return ErrInvalidMatchStart
+	}
+	if mo.Count < 0 || mo.Count > maxMatchIDs {
+		return ErrInvalidMatchCount
+	}
+	if mo.Type != "" && !mo.Type.Valid() {
+		return ErrInvalidMatchType
+	}
+	if !mo.StartTime.IsZero() && mo.StartTime.Before(MatchListStartTimeEpoch) {
+		return ErrStartTimeBeforeEpoch
+	}
+	if !mo.StartTime.IsZero() && !mo.EndTime.IsZero() && mo.EndTime.Before(mo.StartTime) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
+
+// Values returns the query parameters of the options
+func (mo *MatchListOptions) Values() url.Values {
+	values := url.Values{}
 	if mo.Queue != nil {
-		param += "&queue=" + fmt.Sprint(*mo.Queue)
+		values.Set("queue", strconv.Itoa(int(*mo.Queue)))
 	}
 	if mo.Type != "" {
-		param += "&type=" + mo.Type
+		values.Set("type", string(mo.Type))
+	}
+	if mo.Start != 0 {
+		values.Set("start", strconv.Itoa(mo.Start))
+	}
+	if mo.Count != 0 {
+		values.Set("count", strconv.Itoa(mo.Count))
 	}
 	if !mo.StartTime.IsZero() {
-		param += "&startTime=" + fmt.Sprint(mo.StartTime.Unix())
+		values.Set("startTime", strconv.FormatInt(mo.StartTime.Unix(), 10))
 	}
 	if !mo.EndTime.IsZero() {
-		param += "&endTime=" + fmt.Sprint(mo.EndTime.Unix())
+		values.Set("endTime", strconv.FormatInt(mo.EndTime.Unix(), 10))
+	}
+	return values
+}
+
+// copy returns a deep copy of the options
+func (mo *MatchListOptions) copy() *MatchListOptions {
+	res := *mo
+	if mo.Queue != nil {
+		queue := *mo.Queue
+		res.Queue = &queue
 	}
-	return param
+	return &res
 }
 
 // Get returns a match specified by its ID
@@ -61,17 +158,44 @@ func (m *MatchClient) Get(id string) (*Match, error) {
 	return match, nil
 }
 
-// List returns  a list of match ids by puuid
+// List returns a list of match ids by puuid. The start and count arguments take precedence over the values set in
+// the options and are always sent, even if zero.
 func (m *MatchClient) List(puuid string, start, count int, options ...*MatchListOptions) (
 	[]string, error,
 ) {
-	logger := m.logger().WithField("method", "List")
+	opts := &MatchListOptions{}
+	if len(options) != 0 && options[0] != nil {
+		opts = options[0].copy()
+	}
+	opts.Start = start
+	opts.Count = count
+	values := opts.Values()
+	values.Set("start", strconv.Itoa(start))
+	values.Set("count", strconv.Itoa(count))
+	return m.list(m.logger().WithField("method", "List"), puuid, opts, values)
+}
+
+// ListWithOptions returns a list of match ids by puuid. The options are validated before the request is sent.
+func (m *MatchClient) ListWithOptions(puuid string, options *MatchListOptions) ([]string, error) {
+	if options == nil {
+		options = &MatchListOptions{}
+	}
+	return m.list(m.logger().WithField("method", "ListWithOptions"), puuid, options, options.Values())
+}
+
+func (m *MatchClient) list(
+	logger log.FieldLogger, puuid string, options *MatchListOptions, values url.Values,
+) ([]string, error) {
+	if err := options.Validate(); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
 	c := *m.c                                          // copy client
 	c.Region = api.Region(api.RegionToRoute[c.Region]) // Match v5 uses a route instead of a region
 	var matches []string
-	endpoint := fmt.Sprintf(endpointGetMatchIDs, puuid, start, count)
-	if len(options) != 0 {
-		endpoint += options[0].buildParam()
+	endpoint := fmt.Sprintf(endpointGetMatchIDs, puuid)
+	if len(values) != 0 {
+		endpoint += "?" + values.Encode()
 	}
 	if err := c.GetInto(endpoint, &matches); err != nil {
 		logger.Debug(err)
@@ -80,6 +204,26 @@ func (m *MatchClient) List(puuid string, start, count int, options ...*MatchList
 	return matches, nil
 }
 
+// Iterate returns an iterator over all match ids played on this account, requesting up to 100 ids per page.
+// Start and Count of the options are replaced by the pages of the iterator.
+func (m *MatchClient) Iterate(
+	puuid string, pageOptions *pagination.Options, options ...*MatchListOptions,
+) *pagination.Iterator[string] {
+	// Copy the options to prevent caller modification while iterating
+	opts := &MatchListOptions{}
+	if len(options) != 0 && options[0] != nil {
+		opts = options[0].copy()
+	}
+	return pagination.New(
+		func(page pagination.Page) ([]string, error) {
+			pageOpts := opts.copy()
+			pageOpts.Start = page.Start
+			pageOpts.Count = page.Count
+			return m.ListWithOptions(puuid, pageOpts)
+		}, maxMatchIDs, pageOptions,
+	)
+}
+
 // MatchStreamValue value returned by ListStream, containing either a reference to a match or an error
 type MatchStreamValue struct {
 	MatchID string
@@ -88,42 +232,21 @@ type MatchStreamValue struct {
 
 // ListStream returns all matches played on this account as a stream, requesting new until there are no
 // more new games
+//
+// Deprecated: ListStream can not be stopped before all matches were sent. Use Iterate instead.
 func (m *MatchClient) ListStream(puuid string, options ...*MatchListOptions) <-chan MatchStreamValue {
 	logger := m.logger().WithField("method", "ListStream")
-	cMatches := make(chan MatchStreamValue, 100)
-
-	// Copy the input options to prevent caller modification while streaming
-	opts := make([]*MatchListOptions, 0)
-	for _, o := range options {
-		// Copy the value in case the caller modifies it after we return
-		queue := *options[0].Queue
-		// Shallow copy the other values
-		newOpt := *o
-		newOpt.Queue = &queue
-		opts = append(opts, &newOpt)
-	}
-	if len(options) != 0 && options[0].Queue != nil {
-		// Copy the value in case the caller modifies it after we return
-		queue := *options[0].Queue
-		options[0].Queue = &queue
-	}
+	cMatches := make(chan MatchStreamValue, maxMatchIDs)
+	it := m.Iterate(puuid, nil, options...)
 	go func() {
 		defer close(cMatches)
-		start := 0
-		for {
-			matches, err := m.List(puuid, start, 100, opts...)
+		for id, err := range it.All() {
 			if err != nil {
 				logger.Debug(err)
 				cMatches <- MatchStreamValue{Error: err}
 				return
 			}
-			for _, match := range matches {
-				cMatches <- MatchStreamValue{MatchID: match}
-			}
-			if len(matches) < 100 {
-				return
-			}
-			start += 100
+			cMatches <- MatchStreamValue{MatchID: id}
 		}
 	}()
 	return cMatches
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/pagination"
 )
 
 func TestMatchClient_List(t *testing.T) {
@@ -39,11 +41,11 @@ func TestMatchClient_List(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
-				queue := 200
+				queue := QueueID(200)
 				got, err := (&MatchClient{c: client}).List(
 					"id", 0, 1, &MatchListOptions{
 						Queue:     &queue,
-						Type:      "SomeType",
+						Type:      MatchTypeRanked,
 						StartTime: time.Now(),
 						EndTime:   time.Now().Add(time.Hour),
 					},
@@ -87,11 +89,11 @@ func TestMatchClient_ListStream(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
-				queue := 200
+				queue := QueueID(200)
 				got := (&MatchClient{c: client}).ListStream(
 					"id", &MatchListOptions{
 						Queue:     &queue,
-						Type:      "SomeType",
+						Type:      MatchTypeRanked,
 						StartTime: time.Now(),
 						EndTime:   time.Now().Add(time.Hour),
 					},
@@ -110,6 +112,149 @@ func TestMatchClient_ListStream(t *testing.T) {
 	}
 }
 
+func TestMatchListOptions_Validate(t *testing.T) {
+	t.Parallel()
+	after := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		options MatchListOptions
+		wantErr error
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			options: MatchListOptions{
+				Type:      MatchTypeTourney,
+				Start:     100,
+				Count:     100,
+				StartTime: after,
+				EndTime:   after.Add(time.Hour),
+			},
+		},
+		{
+			name:    "negative start",
+			options: MatchListOptions{Start: -1},
+			wantErr: ErrInvalidMatchStart,
+		},
+		{
+			name:    "count too high",
+			options: MatchListOptions{Count: 101},
+			wantErr: ErrInvalidMatchCount,
+		},
+		{
+			name:    "invalid type",
+			options: MatchListOptions{Type: "ranked5x5"},
+			wantErr: ErrInvalidMatchType,
+		},
+		{
+			name:    "start time before epoch",
+			options: MatchListOptions{StartTime: MatchListStartTimeEpoch.Add(-time.Second)},
+			wantErr: ErrStartTimeBeforeEpoch,
+		},
+		{
+			name:    "end time before start time",
+			options: MatchListOptions{StartTime: after, EndTime: after.Add(-time.Hour)},
+			wantErr: ErrInvalidTimeRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.wantErr, tt.options.Validate())
+			},
+		)
+	}
+}
+
+func TestMatchClient_ListWithOptions(t *testing.T) {
+	t.Parallel()
+	var query string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			query = r.URL.RawQuery
+			return mock.NewJSONMockDoer([]string{}, 200).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	queue := Queue5v5RankedSolo
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := (&MatchClient{c: client}).ListWithOptions(
+		"id", &MatchListOptions{
+			Queue:     &queue,
+			Type:      MatchTypeRanked,
+			Count:     50,
+			StartTime: start,
+			EndTime:   start.Add(time.Hour),
+		},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, "count=50&endTime=1672534800&queue=420&startTime=1672531200&type=ranked", query)
+
+	_, err = (&MatchClient{c: client}).List("id", 0, 101)
+	assert.Equal(t, ErrInvalidMatchCount, err)
+}
+
+func TestMatchClient_ListSendsStartAndCount(t *testing.T) {
+	t.Parallel()
+	var query string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			query = r.URL.RawQuery
+			return mock.NewJSONMockDoer([]string{}, 200).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	_, err := (&MatchClient{c: client}).List("id", 0, 0, &MatchListOptions{Start: 10, Count: 50})
+	require.Nil(t, err)
+	assert.Equal(t, "count=0&start=0", query)
+}
+
+func TestMatchClient_Iterate(t *testing.T) {
+	t.Parallel()
+	var requests []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requests = append(requests, r.URL.RawQuery)
+			start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+			count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+			ids := []string{}
+			for i := start; i < 150 && i < start+count; i++ {
+				ids = append(ids, strconv.Itoa(i))
+			}
+			return mock.NewJSONMockDoer(ids, 200).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	got, err := (&MatchClient{c: client}).Iterate("id", nil, &MatchListOptions{Type: MatchTypeRanked}).Collect()
+	require.Nil(t, err)
+	assert.Len(t, got, 150)
+	assert.Equal(t, "149", got[149])
+	assert.Equal(
+		t, []string{"count=100&type=ranked", "count=100&start=100&type=ranked"}, requests,
+	)
+
+	requests = nil
+	got, err = (&MatchClient{c: client}).Iterate("id", &pagination.Options{PageSize: 20, MaxItems: 30}).Collect()
+	require.Nil(t, err)
+	assert.Len(t, got, 30)
+	assert.Equal(t, []string{"count=20", "count=10&start=20"}, requests)
+}
+
+func TestMatchClient_ListStreamWithoutQueue(t *testing.T) {
+	t.Parallel()
+	client := internal.NewClient(
+		api.RegionEuropeWest, "API_KEY", mock.NewJSONMockDoer([]string{"1"}, 200), logrus.StandardLogger(),
+	)
+	var got []string
+	for res := range (&MatchClient{c: client}).ListStream("id", &MatchListOptions{Type: MatchTypeRanked}) {
+		require.Nil(t, res.Error)
+		got = append(got, res.MatchID)
+	}
+	assert.Equal(t, []string{"1"}, got)
+}
+
 func TestMatchClient_Get(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
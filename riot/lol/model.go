@@ -1,11 +1,13 @@
 package lol
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/datadragon"
 	"github.com/KnutZuidema/golio/static"
 )
@@ -21,7 +23,7 @@ type ChampionInfo struct {
 func (i *ChampionInfo) GetChampionsForNewPlayers(client *datadragon.Client) ([]datadragon.ChampionDataExtended, error) {
 	res := make([]datadragon.ChampionDataExtended, 0, len(i.FreeChampionIDsForNewPlayers))
 	for _, id := range i.FreeChampionIDsForNewPlayers {
-		champion, err := client.GetChampionByID(strconv.Itoa(id))
+		champion, err := client.GetChampionByKey(id)
 		if err != nil {
 			return nil, err
 		}
@@ -34,7 +36,7 @@ func (i *ChampionInfo) GetChampionsForNewPlayers(client *datadragon.Client) ([]d
 func (i *ChampionInfo) GetChampions(client *datadragon.Client) ([]datadragon.ChampionDataExtended, error) {
 	res := make([]datadragon.ChampionDataExtended, 0, len(i.FreeChampionIDsForNewPlayers))
 	for _, id := range i.FreeChampionIDs {
-		champion, err := client.GetChampionByID(strconv.Itoa(id))
+		champion, err := client.GetChampionByKey(id)
 		if err != nil {
 			return nil, err
 		}
@@ -63,7 +65,7 @@ func (m *ChampionMastery) GetSummoner(client *Client) (*Summoner, error) {
 
 // GetChampion returns the champion of this mastery
 func (m *ChampionMastery) GetChampion(client *datadragon.Client) (datadragon.ChampionDataExtended, error) {
-	return client.GetChampionByID(strconv.Itoa(m.ChampionID))
+	return client.GetChampionByKey(m.ChampionID)
 }
 
 // LeagueList represents a league containing all player entries in it
@@ -221,12 +223,39 @@ type Styles struct {
 	Style       int          `json:"style"`
 }
 
+// All descriptions of Styles
+const (
+	StyleDescriptionPrimary = "primaryStyle"
+	StyleDescriptionSub     = "subStyle"
+)
+
 // ParticipantPerks holds the perks for a participant in a match
 type ParticipantPerks struct {
 	StatPerks *StatPerks `json:"statPerks"`
 	Styles    []Styles   `json:"styles"`
 }
 
+// GetRunePage returns the runes and stat shards selected by the participant
+func (p *ParticipantPerks) GetRunePage(client *datadragon.Client) (datadragon.RunePage, error) {
+	var primary, sub int
+	var perks []int
+	for _, style := range p.Styles {
+		switch style.Description {
+		case StyleDescriptionPrimary:
+			primary = style.Style
+		case StyleDescriptionSub:
+			sub = style.Style
+		}
+		for _, selection := range style.Selections {
+			perks = append(perks, selection.Perk)
+		}
+	}
+	if p.StatPerks != nil {
+		perks = append(perks, p.StatPerks.Offense, p.StatPerks.Flex, p.StatPerks.Defense)
+	}
+	return client.GetRunePage(primary, sub, perks)
+}
+
 // Participant hold information for a participant of a match
 type Participant struct {
 	Assists         int `json:"assists"`
@@ -365,9 +394,14 @@ func (p *Participant) GetProfileIcon(client *datadragon.Client) (datadragon.Prof
 	return client.GetProfileIcon(p.ProfileIcon)
 }
 
-// GetChampion returns the champion played by this participant
+// GetChampion returns the champion played by this participant. If the champion ID is unknown, which is the case for
+// some matches played prior to patch 11.4, the champion is determined by the champion name.
 func (p *Participant) GetChampion(client *datadragon.Client) (datadragon.ChampionDataExtended, error) {
-	return client.GetChampionByID(strconv.Itoa(p.ChampionID))
+	champion, err := client.GetChampionByKey(p.ChampionID)
+	if errors.Is(err, api.ErrNotFound) && p.ChampionName != "" {
+		return client.GetChampionByID(p.ChampionName)
+	}
+	return champion, err
 }
 
 // GetSpell1 returns the first summoner spell of this participant
@@ -425,7 +459,7 @@ type TeamBan struct {
 
 // GetChampion returns the champion that was banned
 func (b *TeamBan) GetChampion(client *datadragon.Client) (datadragon.ChampionDataExtended, error) {
-	return client.GetChampionByID(strconv.Itoa(b.ChampionID))
+	return client.GetChampionByKey(b.ChampionID)
 }
 
 // Objective holds information for a single objective
@@ -573,7 +607,47 @@ type GameInfo struct {
 
 // GetMatch returns information about the finished match
 func (i *GameInfo) GetMatch(client *Client) (*Match, error) {
-	return client.Match.Get(fmt.Sprintf("%v_%v", strings.ToUpper(string(client.Match.c.Region)), i.GameID))
+	return client.Match.Get(i.MatchID(client))
+}
+
+// MatchID returns the ID of the finished match. The platform of the game is used if it is known, otherwise the
+// region of the client.
+func (i *GameInfo) MatchID(client *Client) string {
+	platform := i.PlatformID
+	if platform == "" {
+		platform = string(client.Match.c.Region)
+	}
+	return fmt.Sprintf("%v_%v", strings.ToUpper(platform), i.GameID)
+}
+
+// GetQueue returns the queue this game is played in
+func (i *GameInfo) GetQueue(client *static.Client) (static.Queue, error) {
+	return client.GetQueue(i.GameQueueConfigID)
+}
+
+// GetMap returns the map this game is played on
+func (i *GameInfo) GetMap(client *static.Client) (static.Map, error) {
+	return client.GetMap(i.MapID)
+}
+
+// GetGameType returns the gameType this game is played in
+func (i *GameInfo) GetGameType(client *static.Client) (static.GameType, error) {
+	return client.GetGameType(i.GameType)
+}
+
+// GetGameMode returns the gameMode this game is played in
+func (i *GameInfo) GetGameMode(client *static.Client) (static.GameMode, error) {
+	return client.GetGameMode(i.GameMode)
+}
+
+// GetParticipant returns the participant with the given PUUID or nil if the player is not part of the game
+func (i *GameInfo) GetParticipant(puuid string) *CurrentGameParticipant {
+	for _, participant := range i.Participants {
+		if participant.PUUID == puuid {
+			return participant
+		}
+	}
+	return nil
 }
 
 // BannedChampion represents a champion ban during pack/ban phase
@@ -585,7 +659,7 @@ type BannedChampion struct {
 
 // GetChampion returns the banned champion
 func (c *BannedChampion) GetChampion(client *datadragon.Client) (datadragon.ChampionDataExtended, error) {
-	return client.GetChampionByID(strconv.Itoa(c.ChampionID))
+	return client.GetChampionByKey(c.ChampionID)
 }
 
 // Observer is an observer of an ongoing game
@@ -609,7 +683,7 @@ type CurrentGameParticipant struct {
 
 // GetChampion returns the champion played by this participant
 func (p *CurrentGameParticipant) GetChampion(client *datadragon.Client) (datadragon.ChampionDataExtended, error) {
-	return client.GetChampionByID(strconv.Itoa(p.ChampionID))
+	return client.GetChampionByKey(p.ChampionID)
 }
 
 // GetSpell1 returns the first summoner spell of this participant
@@ -635,6 +709,11 @@ type Perks struct {
 	PerkSubStyle int   `json:"perkSubStyle"`
 }
 
+// GetRunePage returns the runes and stat shards selected by the player
+func (p *Perks) GetRunePage(client *datadragon.Client) (datadragon.RunePage, error) {
+	return client.GetRunePage(p.PerkStyle, p.PerkSubStyle, p.PerksIDs)
+}
+
 // FeaturedGames represents a list of featured games
 type FeaturedGames struct {
 	ClientRefreshInterval int         `json:"clientRefreshInterval"`
@@ -700,44 +779,167 @@ type LobbyEventList struct {
 	EventList []*LobbyEvent `json:"eventList"`
 }
 
+// LobbyEventType is the type of an event in a tournament lobby
+type LobbyEventType string
+
+// All known lobby event types
+const (
+	LobbyEventTypePracticeGameCreated   LobbyEventType = "PracticeGameCreatedEvent"
+	LobbyEventTypePlayerJoinedGame      LobbyEventType = "PlayerJoinedGameEvent"
+	LobbyEventTypePlayerSwitchedTeam    LobbyEventType = "PlayerSwitchedTeamEvent"
+	LobbyEventTypePlayerQuitGame        LobbyEventType = "PlayerQuitGameEvent"
+	LobbyEventTypeChampSelectStarted    LobbyEventType = "ChampSelectStartedEvent"
+	LobbyEventTypeGameAllocationStarted LobbyEventType = "GameAllocationStartedEvent"
+	LobbyEventTypeGameAllocatedToLSM    LobbyEventType = "GameAllocatedToLsmEvent"
+)
+
 // LobbyEvent represents an event that happened in a tournament lobby
 type LobbyEvent struct {
-	EventType string `json:"eventType"`
-	PUUID     string `json:"puuid"`
+	EventType LobbyEventType `json:"eventType"`
+	PUUID     string         `json:"puuid"`
+	// Unix timestamp in milliseconds
 	Timestamp string `json:"timestamp"`
 }
 
+// MapType is the map a tournament game is played on
+type MapType string
+
+// All legal values for tournament map types
+const (
+	MapTypeSummonersRift   MapType = "SUMMONERS_RIFT"
+	MapTypeHowlingAbyss    MapType = "HOWLING_ABYSS"
+	MapTypeTwistedTreeline MapType = "TWISTED_TREELINE"
+)
+
+// PickType is the champion selection mode of a tournament game
+type PickType string
+
+// All legal values for tournament pick types
+const (
+	PickTypeBlindPick       PickType = "BLIND_PICK"
+	PickTypeDraftMode       PickType = "DRAFT_MODE"
+	PickTypeAllRandom       PickType = "ALL_RANDOM"
+	PickTypeTournamentDraft PickType = "TOURNAMENT_DRAFT"
+)
+
+// SpectatorType determines who is allowed to spectate a tournament game
+type SpectatorType string
+
+// All legal values for tournament spectator types
+const (
+	SpectatorTypeNone      SpectatorType = "NONE"
+	SpectatorTypeLobbyOnly SpectatorType = "LOBBYONLY"
+	SpectatorTypeAll       SpectatorType = "ALL"
+)
+
+var (
+	// MapTypes is a list of all available tournament map types
+	MapTypes = []MapType{
+		MapTypeSummonersRift,
+		MapTypeHowlingAbyss,
+		MapTypeTwistedTreeline,
+	}
+
+	// PickTypes is a list of all available tournament pick types
+	PickTypes = []PickType{
+		PickTypeBlindPick,
+		PickTypeDraftMode,
+		PickTypeAllRandom,
+		PickTypeTournamentDraft,
+	}
+
+	// SpectatorTypes is a list of all available tournament spectator types
+	SpectatorTypes = []SpectatorType{
+		SpectatorTypeNone,
+		SpectatorTypeLobbyOnly,
+		SpectatorTypeAll,
+	}
+)
+
+// Valid reports whether m is a legal map type
+func (m MapType) Valid() bool {
+	for _, mapType := range MapTypes {
+		if m == mapType {
+			return true
+		}
+	}
+	return false
+}
+
+// Valid reports whether p is a legal pick type
+func (p PickType) Valid() bool {
+	for _, pickType := range PickTypes {
+		if p == pickType {
+			return true
+		}
+	}
+	return false
+}
+
+// Valid reports whether s is a legal spectator type
+func (s SpectatorType) Valid() bool {
+	for _, spectatorType := range SpectatorTypes {
+		if s == spectatorType {
+			return true
+		}
+	}
+	return false
+}
+
 // Tournament contains the settings of a previously created tournament
 type Tournament struct {
-	Map          string   `json:"map"`
-	Code         string   `json:"code"`
-	Spectators   string   `json:"spectators"`
-	Region       string   `json:"region"`
-	ProviderID   int      `json:"providerId"`
-	TeamSize     int      `json:"teamSize"`
-	Participants []string `json:"participants"`
-	PickType     string   `json:"pickType"`
-	TournamentID int      `json:"tournamentId"`
-	LobbyName    string   `json:"lobbyName"`
-	Password     string   `json:"password"`
-	ID           int      `json:"id"`
-	MetaData     string   `json:"metaData"`
+	Map          MapType       `json:"map"`
+	Code         string        `json:"code"`
+	Spectators   SpectatorType `json:"spectators"`
+	Region       string        `json:"region"`
+	ProviderID   int           `json:"providerId"`
+	TeamSize     int           `json:"teamSize"`
+	Participants []string      `json:"participants"`
+	PickType     PickType      `json:"pickType"`
+	TournamentID int           `json:"tournamentId"`
+	LobbyName    string        `json:"lobbyName"`
+	Password     string        `json:"password"`
+	ID           int           `json:"id"`
+	MetaData     string        `json:"metaData"`
+}
+
+// TournamentGame contains information about a game played with a tournament code
+type TournamentGame struct {
+	WinningTeam []*TournamentTeamMember `json:"winningTeam"`
+	LosingTeam  []*TournamentTeamMember `json:"losingTeam"`
+	// Tournament code used to create the game
+	ShortCode string `json:"shortCode"`
+	// Metadata of the tournament code used to create the game
+	MetaData string `json:"metaData"`
+	GameID   int64  `json:"gameId"`
+	GameName string `json:"gameName"`
+	GameType string `json:"gameType"`
+	// Game map ID
+	GameMap  int    `json:"gameMap"`
+	GameMode string `json:"gameMode"`
+	// Region of the game
+	Region string `json:"region"`
+}
+
+// TournamentTeamMember is a player of a team in a tournament game
+type TournamentTeamMember struct {
+	PUUID string `json:"puuid"`
 }
 
 // TournamentCodeParameters parameters needed to create tournament codes
 type TournamentCodeParameters struct {
-	// The spectator type of the game. (Legal values: NONE, LOBBYONLY, ALL)
-	SpectatorType string `json:"spectatorType"`
+	// The spectator type of the game.
+	SpectatorType SpectatorType `json:"spectatorType"`
 	// The team size of the game. Valid values are 1-5.
 	TeamSize int `json:"teamSize"`
-	// The pick type of the game. (Legal values: BLIND_PICK, DRAFT_MODE, ALL_RANDOM, TOURNAMENT_DRAFT)
-	PickType string `json:"pickType"`
-	// Optional list of encrypted summonerIds in order to validate the players eligible to join the lobby.
+	// The pick type of the game.
+	PickType PickType `json:"pickType"`
+	// Optional list of encrypted PUUIDs in order to validate the players eligible to join the lobby.
 	// NOTE: We currently do not enforce participants at the team level, but rather the aggregate of teamOne and
 	// teamTwo. We may add the ability to enforce at the team level in the future.
 	AllowedParticipants []string `json:"allowedParticipants,omitempty"`
-	// The map type of the game. (Legal values: SUMMONERS_RIFT, TWISTED_TREELINE, HOWLING_ABYSS)
-	MapType string `json:"mapType"`
+	// The map type of the game.
+	MapType MapType `json:"mapType"`
 	// Optional string that may contain any data in any format, if specified at all. Used to denote any custom
 	// information about the game.
 	Metadata string `json:"metadata"`
@@ -745,17 +947,77 @@ type TournamentCodeParameters struct {
 	EnoughPlayers bool `json:"enoughPlayers"`
 }
 
+// Validate checks the parameters for values the API would reject
+func (p *TournamentCodeParameters) Validate() error {
+	if p == nil {
+		return ErrNilParameters
+	}
+	if p.TeamSize < minTournamentTeamSize || p.TeamSize > maxTournamentTeamSize {
+		return ErrInvalidTeamSize
+	}
+	if !p.MapType.Valid() {
+		return ErrInvalidMapType
+	}
+	if !p.PickType.Valid() {
+		return ErrInvalidPickType
+	}
+	if !p.SpectatorType.Valid() {
+		return ErrInvalidSpectatorType
+	}
+	if err := validateParticipants(p.AllowedParticipants); err != nil {
+		return err
+	}
+	if p.EnoughPlayers && len(p.AllowedParticipants) < 2*p.TeamSize {
+		return ErrNotEnoughParticipants
+	}
+	return nil
+}
+
 // TournamentUpdateParameters parameters needed to update an existing tournament
 type TournamentUpdateParameters struct {
-	// The spectator type (Legal values: NONE, LOBBYONLY, ALL)
-	SpectatorType string `json:"spectatorType"`
-	// The pick type (Legal values: BLIND_PICK, DRAFT_MODE, ALL_RANDOM, TOURNAMENT_DRAFT)
-	PickType string `json:"pickType"`
-	// Optional list of encrypted summonerIds in order to validate the players eligible to join the lobby.
+	// The spectator type
+	SpectatorType SpectatorType `json:"spectatorType"`
+	// The pick type
+	PickType PickType `json:"pickType"`
+	// Optional list of encrypted PUUIDs in order to validate the players eligible to join the lobby.
 	// NOTE: Participants are not enforced at the team level, but rather the aggregate of teamOne and teamTwo.
 	AllowedParticipants []string `json:"allowedParticipants"`
-	// The map type (Legal values: SUMMONERS_RIFT, TWISTED_TREELINE, HOWLING_ABYSS)
-	MapType string `json:"mapType"`
+	// The map type
+	MapType MapType `json:"mapType"`
+}
+
+// Validate checks the parameters for values the API would reject
+func (p *TournamentUpdateParameters) Validate() error {
+	if p == nil {
+		return ErrNilParameters
+	}
+	if !p.MapType.Valid() {
+		return ErrInvalidMapType
+	}
+	if !p.PickType.Valid() {
+		return ErrInvalidPickType
+	}
+	if !p.SpectatorType.Valid() {
+		return ErrInvalidSpectatorType
+	}
+	return validateParticipants(p.AllowedParticipants)
+}
+
+func validateParticipants(participants []string) error {
+	if len(participants) > 2*maxTournamentTeamSize {
+		return ErrTooManyParticipants
+	}
+	seen := make(map[string]struct{}, len(participants))
+	for _, participant := range participants {
+		if participant == "" {
+			return ErrInvalidParticipant
+		}
+		if _, ok := seen[participant]; ok {
+			return ErrDuplicateParticipant
+		}
+		seen[participant] = struct{}{}
+	}
+	return nil
 }
 
 // TournamentRegistrationParameters parameters required for creating a tournament
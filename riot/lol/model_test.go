@@ -464,6 +464,36 @@ func TestParticipant_GetChampion(t *testing.T) {
 				ChampionData: datadragon.ChampionData{ID: "1", Name: "champion", Key: "1"},
 			},
 		},
+		{
+			name: "key differs from id",
+			doer: dataDragonResponseDoer(
+				map[string]datadragon.ChampionData{
+					"MonkeyKing": {Key: "62", ID: "MonkeyKing", Name: "Wukong"},
+				},
+			),
+			model: Participant{ChampionID: 62},
+			want: datadragon.ChampionDataExtended{
+				ChampionData: datadragon.ChampionData{ID: "MonkeyKing", Name: "Wukong", Key: "62"},
+			},
+		},
+		{
+			name: "invalid id",
+			doer: dataDragonResponseDoer(
+				map[string]datadragon.ChampionData{
+					"MonkeyKing": {Key: "62", ID: "MonkeyKing", Name: "Wukong"},
+				},
+			),
+			model: Participant{ChampionID: 1, ChampionName: "MonkeyKing"},
+			want: datadragon.ChampionDataExtended{
+				ChampionData: datadragon.ChampionData{ID: "MonkeyKing", Name: "Wukong", Key: "62"},
+			},
+		},
+		{
+			name:    "not found",
+			doer:    dataDragonResponseDoer(map[string]datadragon.ChampionData{}),
+			model:   Participant{ChampionID: 1},
+			wantErr: api.ErrNotFound,
+		},
 	}
 	for _, test := range tests {
 		t.Run(
@@ -925,6 +955,74 @@ func TestGameInfo_GetMatch(t *testing.T) {
 	}
 }
 
+func TestGameInfo_MatchID(t *testing.T) {
+	client := NewClient(internal.NewClient(api.RegionKorea, "key", mock.NewStatusMockDoer(200), log.StandardLogger()))
+	assert.Equal(t, "EUW1_1", (&GameInfo{GameID: 1, PlatformID: "EUW1"}).MatchID(client))
+	assert.Equal(t, "KR_1", (&GameInfo{GameID: 1}).MatchID(client))
+}
+
+func TestGameInfo_GetQueue(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		model   GameInfo
+		want    static.Queue
+		wantErr error
+	}
+	tests := []test{
+		{
+			name:  "valid",
+			doer:  mock.NewJSONMockDoer([]static.Queue{{ID: 420}}, 200),
+			model: GameInfo{GameQueueConfigID: 420},
+			want:  static.Queue{ID: 420},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				client := static.NewClient(test.doer, log.StandardLogger())
+				got, err := test.model.GetQueue(client)
+				assert.Equal(t, test.wantErr, err)
+				assert.Equal(t, test.want, got)
+			},
+		)
+	}
+}
+
+func TestGameInfo_GetMap(t *testing.T) {
+	type test struct {
+		name    string
+		doer    internal.Doer
+		model   GameInfo
+		want    static.Map
+		wantErr error
+	}
+	tests := []test{
+		{
+			name:  "valid",
+			doer:  mock.NewJSONMockDoer([]static.Map{{ID: 11}}, 200),
+			model: GameInfo{MapID: 11},
+			want:  static.Map{ID: 11},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				client := static.NewClient(test.doer, log.StandardLogger())
+				got, err := test.model.GetMap(client)
+				assert.Equal(t, test.wantErr, err)
+				assert.Equal(t, test.want, got)
+			},
+		)
+	}
+}
+
+func TestGameInfo_GetParticipant(t *testing.T) {
+	info := &GameInfo{Participants: []*CurrentGameParticipant{{PUUID: "a"}, {PUUID: "b", TeamID: 200}}}
+	assert.Equal(t, &CurrentGameParticipant{PUUID: "b", TeamID: 200}, info.GetParticipant("b"))
+	assert.Nil(t, info.GetParticipant("c"))
+}
+
 func TestMatchEvent_GetItem(t *testing.T) {
 	type test struct {
 		name    string
@@ -964,6 +1062,71 @@ type dataDragonResponse struct {
 	Data    interface{}
 }
 
+func TestParticipantPerks_GetRunePage(t *testing.T) {
+	t.Parallel()
+	client := datadragon.NewClient(runeTreesDoer(), api.RegionKorea, log.StandardLogger())
+	perks := &ParticipantPerks{
+		StatPerks: &StatPerks{Offense: 5005, Flex: 5008, Defense: 5011},
+		Styles: []Styles{
+			{
+				Description: StyleDescriptionPrimary,
+				Style:       8000,
+				Selections:  []Selections{{Perk: 8005}, {Perk: 9111}},
+			},
+			{
+				Description: StyleDescriptionSub,
+				Style:       8100,
+				Selections:  []Selections{{Perk: 8126}},
+			},
+		},
+	}
+	got, err := perks.GetRunePage(client)
+	require.Nil(t, err)
+	assert.Equal(t, 8000, got.Primary.ID)
+	assert.Equal(t, 8100, got.Secondary.ID)
+	assert.Equal(t, []int{8005, 9111, 8126}, runeIDs(got.Runes))
+	assert.Equal(t, []int{5005, 5008, 5011}, runeIDs(got.StatShards))
+}
+
+func TestPerks_GetRunePage(t *testing.T) {
+	t.Parallel()
+	client := datadragon.NewClient(runeTreesDoer(), api.RegionKorea, log.StandardLogger())
+	perks := &Perks{PerkStyle: 8100, PerkSubStyle: 8000, PerksIDs: []int{8126, 9111, 5008, 5008, 5002}}
+	got, err := perks.GetRunePage(client)
+	require.Nil(t, err)
+	assert.Equal(t, 8100, got.Primary.ID)
+	assert.Equal(t, []int{8126, 9111}, runeIDs(got.Runes))
+	assert.Equal(t, []int{5008, 5008, 5002}, runeIDs(got.StatShards))
+	_, err = (&Perks{PerkStyle: 1}).GetRunePage(client)
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func runeTreesDoer() internal.Doer {
+	return mock.NewJSONMockDoer(
+		[]datadragon.RuneTree{
+			{
+				ID: 8000,
+				Slots: []datadragon.RuneSlot{
+					{Runes: []datadragon.Rune{{ID: 8005}}},
+					{Runes: []datadragon.Rune{{ID: 9111}}},
+				},
+			},
+			{
+				ID:    8100,
+				Slots: []datadragon.RuneSlot{{Runes: []datadragon.Rune{{ID: 8126}}}},
+			},
+		}, 200,
+	)
+}
+
+func runeIDs(runes []datadragon.Rune) []int {
+	ids := make([]int, 0, len(runes))
+	for _, r := range runes {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
 func dataDragonResponseDoer(object interface{}) internal.Doer {
 	return mock.NewJSONMockDoer(
 		dataDragonResponse{
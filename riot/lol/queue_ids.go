@@ -0,0 +1,59 @@
+// Code generated by queuegen; DO NOT EDIT.
+
+package lol
+
+// All queues listed by the static data which are not deprecated
+const (
+	// Custom games
+	QueueCustomGames QueueID = 0
+	// Summoner's Rift - 5v5 Draft Pick games
+	Queue5v5DraftPick QueueID = 400
+	// Summoner's Rift - 5v5 Ranked Solo games
+	Queue5v5RankedSolo QueueID = 420
+	// Summoner's Rift - 5v5 Blind Pick games
+	Queue5v5BlindPick QueueID = 430
+	// Summoner's Rift - 5v5 Ranked Flex games
+	Queue5v5RankedFlex QueueID = 440
+	// Howling Abyss - 5v5 ARAM games
+	Queue5v5ARAM QueueID = 450
+	// Summoner's Rift - Normal (Quickplay)
+	QueueNormalQuickplay QueueID = 490
+	// Summoner's Rift - Summoner's Rift Clash games
+	QueueSummonersRiftClash QueueID = 700
+	// Howling Abyss - ARAM Clash games
+	QueueARAMClash QueueID = 720
+	// Summoner's Rift - Co-op vs. AI Intro Bot games
+	QueueCoOpVsAIIntroBot QueueID = 870
+	// Summoner's Rift - Co-op vs. AI Beginner Bot games
+	QueueCoOpVsAIBeginnerBot QueueID = 880
+	// Summoner's Rift - Co-op vs. AI Intermediate Bot games
+	QueueCoOpVsAIIntermediateBot QueueID = 890
+	// Summoner's Rift - ARURF games
+	QueueARURF QueueID = 900
+	// Summoner's Rift - One for All games
+	QueueOneForAll QueueID = 1020
+	// Convergence - Teamfight Tactics games
+	QueueTeamfightTactics QueueID = 1090
+	// Convergence - Ranked Teamfight Tactics games
+	QueueRankedTeamfightTactics QueueID = 1100
+	// Convergence - Ranked Teamfight Tactics (Hyper Roll) games
+	QueueRankedTeamfightTacticsHyperRoll QueueID = 1130
+	// Convergence - Ranked Teamfight Tactics (Double Up Workshop) games
+	QueueRankedTeamfightTacticsDoubleUpWorkshop QueueID = 1160
+	// Nexus Blitz - Nexus Blitz games
+	QueueNexusBlitz QueueID = 1300
+	// Summoner's Rift - Ultimate Spellbook games
+	QueueUltimateSpellbook QueueID = 1400
+	// Rings of Wrath - Arena
+	QueueArena1700 QueueID = 1700
+	// Rings of Wrath - Arena - 16 player lobby
+	QueueArena1710 QueueID = 1710
+	// Summoner's Rift - Pick URF games
+	QueuePickURF QueueID = 1900
+	// Summoner's Rift - Tutorial 1
+	QueueTutorial1 QueueID = 2000
+	// Summoner's Rift - Tutorial 2
+	QueueTutorial2 QueueID = 2010
+	// Summoner's Rift - Tutorial 3
+	QueueTutorial3 QueueID = 2020
+)
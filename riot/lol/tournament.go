@@ -1,6 +1,7 @@
 package lol
 
 import (
+	"errors"
 	"fmt"
 
 	log "github.com/sirupsen/logrus"
@@ -9,6 +10,26 @@ import (
 	"github.com/KnutZuidema/golio/internal"
 )
 
+const (
+	minTournamentTeamSize = 1
+	maxTournamentTeamSize = 5
+	maxTournamentCodes    = 1000
+)
+
+// All errors returned by the validation of tournament parameters
+var (
+	ErrInvalidTeamSize       = errors.New("team size must be between 1 and 5")
+	ErrInvalidCodeCount      = errors.New("code count must be between 1 and 1000")
+	ErrInvalidMapType        = errors.New("invalid map type")
+	ErrInvalidPickType       = errors.New("invalid pick type")
+	ErrInvalidSpectatorType  = errors.New("invalid spectator type")
+	ErrInvalidParticipant    = errors.New("allowed participants must not be empty")
+	ErrDuplicateParticipant  = errors.New("allowed participants must not contain duplicates")
+	ErrTooManyParticipants   = errors.New("at most 10 allowed participants can be specified")
+	ErrNotEnoughParticipants = errors.New("not enough allowed participants to fill both teams")
+	ErrNilParameters         = errors.New("parameters must not be nil")
+)
+
 // TournamentClient provides methods for the tournament endpoints of the League of Legends API.
 type TournamentClient struct {
 	c *internal.Client
@@ -16,6 +37,7 @@ type TournamentClient struct {
 
 // CreateCodes creates a specified amount of codes for a tournament.
 // For more information about the parameters see the documentation for TournamentCodeParameters.
+// The parameters are validated before the request is sent.
 // Set the useStub flag to true to use the stub endpoints for mocking an implementation
 func (t *TournamentClient) CreateCodes(id, count int, params *TournamentCodeParameters, stub bool) ([]string, error) {
 	logger := t.logger().WithFields(
@@ -24,13 +46,20 @@ func (t *TournamentClient) CreateCodes(id, count int, params *TournamentCodePara
 			"stub":   stub,
 		},
 	)
-	t.c.Region = api.Region(api.RegionToRoute[t.c.Region])
+	if count < 1 || count > maxTournamentCodes {
+		return nil, ErrInvalidCodeCount
+	}
+	if err := params.Validate(); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	c := t.routed()
 	endpoint := endpointCreateTournamentCodes
 	if stub {
 		endpoint = endpointCreateStubTournamentCodes
 	}
 	var codes []string
-	if err := t.c.PostInto(fmt.Sprintf(endpoint, count, id), params, &codes); err != nil {
+	if err := c.PostInto(fmt.Sprintf(endpoint, count, id), params, &codes); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -46,13 +75,13 @@ func (t *TournamentClient) ListLobbyEvents(code string, useStub bool) (*LobbyEve
 			"stub":   useStub,
 		},
 	)
-	t.c.Region = api.Region(api.RegionToRoute[t.c.Region])
+	c := t.routed()
 	endpoint := endpointGetLobbyEvents
 	if useStub {
 		endpoint = endpointGetStubLobbyEvents
 	}
 	var events LobbyEventList
-	if err := t.c.GetInto(fmt.Sprintf(endpoint, code), &events); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpoint, code), &events); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -69,13 +98,13 @@ func (t *TournamentClient) CreateProvider(parameters *ProviderRegistrationParame
 			"stub":   useStub,
 		},
 	)
-	t.c.Region = api.Region(api.RegionToRoute[t.c.Region])
+	c := t.routed()
 	endpoint := endpointCreateTournamentProvider
 	if useStub {
 		endpoint = endpointCreateStubTournamentProvider
 	}
 	var id int
-	if err := t.c.PostInto(endpoint, parameters, &id); err != nil {
+	if err := c.PostInto(endpoint, parameters, &id); err != nil {
 		logger.Debug(err)
 		return 0, err
 	}
@@ -92,49 +121,93 @@ func (t *TournamentClient) Create(parameters *TournamentRegistrationParameters,
 			"stub":   useStub,
 		},
 	)
-	t.c.Region = api.Region(api.RegionToRoute[t.c.Region])
+	c := t.routed()
 	endpoint := endpointCreateTournament
 	if useStub {
 		endpoint = endpointCreateStubTournament
 	}
 	var id int
-	if err := t.c.PostInto(endpoint, parameters, &id); err != nil {
+	if err := c.PostInto(endpoint, parameters, &id); err != nil {
 		logger.Debug(err)
 		return 0, err
 	}
 	return id, nil
 }
 
-// Get returns an existing tournament
+// Get returns the settings of the tournament code
 func (t *TournamentClient) Get(code string) (*Tournament, error) {
+	return t.get(code, false)
+}
+
+// GetStub returns the settings of the tournament code from the stub endpoint for mocking an implementation
+func (t *TournamentClient) GetStub(code string) (*Tournament, error) {
+	return t.get(code, true)
+}
+
+func (t *TournamentClient) get(code string, useStub bool) (*Tournament, error) {
 	logger := t.logger().WithFields(
 		log.Fields{
 			"method": "Get",
+			"stub":   useStub,
 		},
 	)
+	c := t.routed()
+	endpoint := endpointGetTournament
+	if useStub {
+		endpoint = endpointGetStubTournament
+	}
 	var tournament Tournament
-	if err := t.c.GetInto(fmt.Sprintf(endpointGetTournament, code), &tournament); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpoint, code), &tournament); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
 	return &tournament, nil
 }
 
-// Update updates an existing tournament
-func (t *TournamentClient) Update(code string, parameters TournamentUpdateParameters) error {
+// ListGames returns the games played with the tournament code. There is no stub endpoint for this method.
+func (t *TournamentClient) ListGames(code string) ([]*TournamentGame, error) {
+	logger := t.logger().WithFields(
+		log.Fields{
+			"method": "ListGames",
+		},
+	)
+	c := t.routed()
+	var games []*TournamentGame
+	if err := c.GetInto(fmt.Sprintf(endpointGetTournamentGames, code), &games); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return games, nil
+}
+
+// Update updates the settings of an existing tournament code.
+// The parameters are validated before the request is sent.
+func (t *TournamentClient) Update(code string, parameters *TournamentUpdateParameters) error {
 	logger := t.logger().WithFields(
 		log.Fields{
 			"method": "Update",
 		},
 	)
-	t.c.Region = api.Region(api.RegionToRoute[t.c.Region])
-	if err := t.c.Put(fmt.Sprintf(endpointUpdateTournament, code), parameters); err != nil {
+	if err := parameters.Validate(); err != nil {
+		logger.Debug(err)
+		return err
+	}
+	c := t.routed()
+	if err := c.Put(fmt.Sprintf(endpointUpdateTournament, code), parameters); err != nil {
 		logger.Debug(err)
 		return err
 	}
 	return nil
 }
 
+// routed returns a copy of the client which uses the route of its region, as the tournament endpoints
+// are only available on the regional routes
+func (t *TournamentClient) routed() *internal.Client {
+	c := *t.c
+	c.Region = api.Region(api.RegionToRoute[c.Region])
+	return &c
+}
+
 func (t *TournamentClient) logger() log.FieldLogger {
 	return t.c.Logger().WithField("category", "tournament")
 }
@@ -0,0 +1,364 @@
+package tournament
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+const (
+	defaultReplayWindow = 24 * time.Hour
+	maxCallbackSize     = 1 << 20
+)
+
+// Errors returned when a callback is rejected
+var (
+	ErrMalformedCallback = errors.New("malformed callback payload")
+	ErrUnknownCode       = errors.New("callback for unknown tournament code")
+	ErrStaleCallback     = errors.New("callback outside of the replay window")
+)
+
+// Callback is the payload posted to the callback URL of a provider when a game played with a tournament code ended
+type Callback struct {
+	// Start time of the game in Unix milliseconds
+	StartTime int64 `json:"startTime"`
+	// Tournament code used to create the game
+	ShortCode string `json:"shortCode"`
+	// Metadata of the tournament code used to create the game
+	MetaData    string                      `json:"metaData"`
+	GameID      int64                       `json:"gameId"`
+	GameName    string                      `json:"gameName"`
+	GameType    string                      `json:"gameType"`
+	GameMap     int                         `json:"gameMap"`
+	GameMode    string                      `json:"gameMode"`
+	Region      string                      `json:"region"`
+	WinningTeam []*lol.TournamentTeamMember `json:"winningTeam"`
+	LosingTeam  []*lol.TournamentTeamMember `json:"losingTeam"`
+}
+
+// Game returns the game described by the callback
+func (c *Callback) Game() *lol.TournamentGame {
+	return &lol.TournamentGame{
+		WinningTeam: c.WinningTeam,
+		LosingTeam:  c.LosingTeam,
+		ShortCode:   c.ShortCode,
+		MetaData:    c.MetaData,
+		GameID:      c.GameID,
+		GameName:    c.GameName,
+		GameType:    c.GameType,
+		GameMap:     c.GameMap,
+		GameMode:    c.GameMode,
+		Region:      c.Region,
+	}
+}
+
+// IdempotencyKey returns a key identifying the game of the callback. Callbacks which are delivered more than once
+// share the same key.
+func (c *Callback) IdempotencyKey() string {
+	return fmt.Sprintf("%s/%d", c.ShortCode, c.GameID)
+}
+
+// Started returns the start time of the game
+func (c *Callback) Started() time.Time {
+	return time.Unix(0, c.StartTime*int64(time.Millisecond))
+}
+
+func (c *Callback) validate() error {
+	if c.ShortCode == "" || c.GameID == 0 || c.Region == "" || c.StartTime == 0 {
+		return ErrMalformedCallback
+	}
+	return nil
+}
+
+// CallbackEvent is dispatched to the CallbackFunc of a CallbackHandler for every accepted callback
+type CallbackEvent struct {
+	Callback *Callback
+	// Idempotency key of the callback, see Callback.IdempotencyKey
+	Key string
+	// The finished match, only set if the handler fetches matches and the match was available
+	Match *lol.Match
+	// The error returned when fetching the match. A missing match is not treated as a failure of the callback, see
+	// lol.MatchAvailabilityDelay.
+	MatchErr error
+}
+
+// CallbackFunc processes an accepted callback. If an error is returned the callback is answered with an internal
+// server error so it is delivered again.
+type CallbackFunc func(event *CallbackEvent) error
+
+// IdempotencyStore records the idempotency keys of processed callbacks.
+// Implementations must be safe for concurrent use.
+type IdempotencyStore interface {
+	// Claim records the key until the expiry and returns false if it was already recorded
+	Claim(key string, expiry time.Time) (bool, error)
+	// Release removes the key so a callback with the same key can be processed again
+	Release(key string) error
+}
+
+// MemoryIdempotencyStore is an IdempotencyStore which keeps the keys in memory
+type MemoryIdempotencyStore struct {
+	mu   sync.Mutex
+	keys map[string]time.Time
+	now  func() time.Time
+}
+
+// NewMemoryIdempotencyStore returns a new empty MemoryIdempotencyStore
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	return &MemoryIdempotencyStore{
+		keys: map[string]time.Time{},
+		now:  time.Now,
+	}
+}
+
+// Claim implements IdempotencyStore. Expired keys are removed on every call.
+func (s *MemoryIdempotencyStore) Claim(key string, expiry time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for k, e := range s.keys {
+		if now.After(e) {
+			delete(s.keys, k)
+		}
+	}
+	if _, ok := s.keys[key]; ok {
+		return false, nil
+	}
+	s.keys[key] = expiry
+	return true, nil
+}
+
+// Release implements IdempotencyStore
+func (s *MemoryIdempotencyStore) Release(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, key)
+	return nil
+}
+
+// CallbackOption configures a CallbackHandler
+type CallbackOption func(*CallbackHandler)
+
+// WithMatchClient fetches the finished match for every accepted callback
+func WithMatchClient(client *lol.MatchClient) CallbackOption {
+	return func(h *CallbackHandler) {
+		h.matches = client
+	}
+}
+
+// WithCodeVerifier only accepts callbacks for which the verifier returns true, e.g. for codes created by the
+// provider. Manager.KnowsCode can be used as a verifier.
+func WithCodeVerifier(verify func(code string) (bool, error)) CallbackOption {
+	return func(h *CallbackHandler) {
+		h.verify = verify
+	}
+}
+
+// WithReplayWindow rejects callbacks of games started longer ago than the window. The default window is 24 hours.
+// The idempotency keys of processed callbacks are kept for the same duration.
+func WithReplayWindow(window time.Duration) CallbackOption {
+	return func(h *CallbackHandler) {
+		h.window = window
+	}
+}
+
+// WithIdempotencyStore sets the store used to detect callbacks which were already processed. By default a
+// MemoryIdempotencyStore is used.
+func WithIdempotencyStore(store IdempotencyStore) CallbackOption {
+	return func(h *CallbackHandler) {
+		h.store = store
+	}
+}
+
+// WithCallbackLogger sets the logger used by the CallbackHandler
+func WithCallbackLogger(logger log.FieldLogger) CallbackOption {
+	return func(h *CallbackHandler) {
+		h.logger = logger
+	}
+}
+
+// CallbackHandler is a http.Handler which receives the callbacks posted to the callback URL of a provider.
+// Every callback is dispatched exactly once to its CallbackFunc, callbacks delivered again are acknowledged
+// without being dispatched. A callback delivered again while it is still being dispatched is answered with
+// 409 Conflict instead, so it is delivered again if the dispatch fails. Only the handler dispatching a callback
+// knows about it, duplicates received by other handlers sharing the IdempotencyStore are acknowledged.
+type CallbackHandler struct {
+	fn       CallbackFunc
+	matches  *lol.MatchClient
+	verify   func(code string) (bool, error)
+	window   time.Duration
+	store    IdempotencyStore
+	logger   log.FieldLogger
+	now      func() time.Time
+	mu       sync.Mutex
+	inflight map[string]bool
+}
+
+// NewCallbackHandler returns a new CallbackHandler dispatching callbacks to the given function
+func NewCallbackHandler(fn CallbackFunc, options ...CallbackOption) *CallbackHandler {
+	h := &CallbackHandler{
+		fn:       fn,
+		window:   defaultReplayWindow,
+		store:    NewMemoryIdempotencyStore(),
+		logger:   log.StandardLogger(),
+		now:      time.Now,
+		inflight: map[string]bool{},
+	}
+	for _, opt := range options {
+		opt(h)
+	}
+	return h
+}
+
+// ServeHTTP implements http.Handler
+func (h *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := h.logger.WithFields(
+		log.Fields{
+			"category": "tournament callback",
+			"method":   "ServeHTTP",
+		},
+	)
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	callback, err := ParseCallback(http.MaxBytesReader(w, r.Body, maxCallbackSize))
+	if err != nil {
+		logger.Debug(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.check(callback); err != nil {
+		logger.Debug(err)
+		if errors.Is(err, ErrUnknownCode) || errors.Is(err, ErrStaleCallback) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+		} else {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+		return
+	}
+	key := callback.IdempotencyKey()
+	if !h.begin(key) {
+		// still being dispatched, the result is not known yet
+		http.Error(w, http.StatusText(http.StatusConflict), http.StatusConflict)
+		return
+	}
+	defer h.end(key)
+	claimed, err := h.store.Claim(key, callback.Started().Add(h.window))
+	if err != nil {
+		logger.Debug(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !claimed {
+		// already processed, acknowledge so the callback is not delivered again
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	event := &CallbackEvent{
+		Callback: callback,
+		Key:      key,
+	}
+	if h.matches != nil {
+		event.Match, event.MatchErr = h.matches.Get(MatchID(callback.Game()))
+	}
+	if err := h.fn(event); err != nil {
+		logger.Debug(err)
+		if err := h.store.Release(key); err != nil {
+			logger.Debug(err)
+		}
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// begin marks the callback with the key as being dispatched. It returns false if it already is.
+func (h *CallbackHandler) begin(key string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.inflight[key] {
+		return false
+	}
+	h.inflight[key] = true
+	return true
+}
+
+func (h *CallbackHandler) end(key string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.inflight, key)
+}
+
+func (h *CallbackHandler) check(callback *Callback) error {
+	if h.window > 0 && h.now().Sub(callback.Started()) > h.window {
+		return ErrStaleCallback
+	}
+	if h.verify != nil {
+		ok, err := h.verify(callback.ShortCode)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrUnknownCode
+		}
+	}
+	return nil
+}
+
+// ParseCallback decodes and validates a callback payload
+func ParseCallback(r io.Reader) (*Callback, error) {
+	var callback Callback
+	if err := json.NewDecoder(r).Decode(&callback); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedCallback, err)
+	}
+	if err := callback.validate(); err != nil {
+		return nil, err
+	}
+	return &callback, nil
+}
+
+// KnowsCode returns whether a code was created by the Manager for one of its bracket matches
+func (m *Manager) KnowsCode(code string) (bool, error) {
+	lobby, err := m.lobbyByCode(code)
+	if err != nil {
+		return false, err
+	}
+	return lobby != nil, nil
+}
+
+// HandleCallback is a CallbackFunc which completes the bracket match the callback was sent for.
+// Callbacks for codes not created by the Manager are ignored.
+func (m *Manager) HandleCallback(event *CallbackEvent) error {
+	lobby, err := m.lobbyByCode(event.Callback.ShortCode)
+	if err != nil {
+		return err
+	}
+	if lobby == nil {
+		return nil
+	}
+	// the match was already requested by the handler if it has a match client
+	_, err = m.complete(lobby.MatchID, event.Callback.Game(), event.Match, event.MatchErr)
+	return err
+}
+
+func (m *Manager) lobbyByCode(code string) (*Lobby, error) {
+	lobbies, err := m.storage.ListLobbies()
+	if err != nil {
+		return nil, err
+	}
+	for _, lobby := range lobbies {
+		if lobby.Code == code {
+			return lobby, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,262 @@
+package tournament
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+var testStart = time.Date(2023, 5, 1, 18, 0, 0, 0, time.UTC)
+
+const testCallback = `{
+	"startTime": 1682964000000,
+	"shortCode": "CODE1",
+	"metaData": "{\"round\":1}",
+	"gameId": 42,
+	"gameName": "game",
+	"gameType": "Practice",
+	"gameMap": 11,
+	"gameMode": "CLASSIC",
+	"region": "EUW1",
+	"winningTeam": [{"puuid": "b"}, {"puuid": "c"}],
+	"losingTeam": [{"puuid": "a"}]
+}`
+
+func postCallback(h http.Handler, body string) int {
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(body)))
+	return recorder.Code
+}
+
+func TestParseCallback(t *testing.T) {
+	t.Parallel()
+	got, err := ParseCallback(strings.NewReader(testCallback))
+	require.Nil(t, err)
+	assert.Equal(t, testStart, got.Started().UTC())
+	assert.Equal(t, "CODE1/42", got.IdempotencyKey())
+	assert.Equal(t, `{"round":1}`, got.MetaData)
+	assert.Equal(t, "EUW1_42", MatchID(got.Game()))
+	assert.Equal(t, []*lol.TournamentTeamMember{{PUUID: "b"}, {PUUID: "c"}}, got.Game().WinningTeam)
+	assert.Equal(t, []*lol.TournamentTeamMember{{PUUID: "a"}}, got.LosingTeam)
+
+	_, err = ParseCallback(strings.NewReader("{"))
+	assert.ErrorIs(t, err, ErrMalformedCallback)
+	_, err = ParseCallback(strings.NewReader(`{"shortCode": "CODE1"}`))
+	assert.Equal(t, ErrMalformedCallback, err)
+}
+
+func TestCallbackHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		method   string
+		body     string
+		now      time.Time
+		verify   func(string) (bool, error)
+		fnErr    error
+		want     int
+		dispatch bool
+	}{
+		{
+			name:     "valid",
+			body:     testCallback,
+			now:      testStart.Add(time.Hour),
+			want:     http.StatusOK,
+			dispatch: true,
+		},
+		{
+			name:   "wrong method",
+			method: http.MethodGet,
+			now:    testStart,
+			want:   http.StatusMethodNotAllowed,
+		},
+		{
+			name: "malformed",
+			body: "[]",
+			now:  testStart,
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "missing start time",
+			body: strings.Replace(testCallback, `"startTime": 1682964000000,`, "", 1),
+			now:  testStart,
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "stale",
+			body: testCallback,
+			now:  testStart.Add(48 * time.Hour),
+			want: http.StatusForbidden,
+		},
+		{
+			name: "unknown code",
+			body: testCallback,
+			now:  testStart,
+			verify: func(string) (bool, error) {
+				return false, nil
+			},
+			want: http.StatusForbidden,
+		},
+		{
+			name: "verification failed",
+			body: testCallback,
+			now:  testStart,
+			verify: func(string) (bool, error) {
+				return false, errors.New("error")
+			},
+			want: http.StatusInternalServerError,
+		},
+		{
+			name:     "dispatch failed",
+			body:     testCallback,
+			now:      testStart,
+			fnErr:    errors.New("error"),
+			want:     http.StatusInternalServerError,
+			dispatch: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var dispatched bool
+				options := []CallbackOption{}
+				if tt.verify != nil {
+					options = append(options, WithCodeVerifier(tt.verify))
+				}
+				h := NewCallbackHandler(
+					func(event *CallbackEvent) error {
+						dispatched = true
+						return tt.fnErr
+					}, options...,
+				)
+				h.now = func() time.Time {
+					return tt.now
+				}
+				method := tt.method
+				if method == "" {
+					method = http.MethodPost
+				}
+				recorder := httptest.NewRecorder()
+				h.ServeHTTP(recorder, httptest.NewRequest(method, "/callback", strings.NewReader(tt.body)))
+				assert.Equal(t, tt.want, recorder.Code)
+				assert.Equal(t, tt.dispatch, dispatched)
+			},
+		)
+	}
+}
+
+func TestCallbackHandler_Idempotency(t *testing.T) {
+	t.Parallel()
+	var count int
+	fail := true
+	now := func() time.Time {
+		return testStart
+	}
+	store := NewMemoryIdempotencyStore()
+	store.now = now
+	h := NewCallbackHandler(
+		func(event *CallbackEvent) error {
+			count++
+			if fail {
+				return errors.New("error")
+			}
+			return nil
+		}, WithIdempotencyStore(store),
+	)
+	h.now = now
+	assert.Equal(t, http.StatusInternalServerError, postCallback(h, testCallback))
+	fail = false
+	assert.Equal(t, http.StatusOK, postCallback(h, testCallback), "failed callbacks should be processed again")
+	assert.Equal(t, http.StatusOK, postCallback(h, testCallback))
+	assert.Equal(t, 2, count)
+}
+
+func TestCallbackHandler_InFlight(t *testing.T) {
+	t.Parallel()
+	started := make(chan struct{})
+	finish := make(chan error)
+	var count int
+	h := NewCallbackHandler(
+		func(event *CallbackEvent) error {
+			count++
+			close(started)
+			return <-finish
+		},
+	)
+	h.now = func() time.Time {
+		return testStart
+	}
+	done := make(chan int)
+	go func() {
+		done <- postCallback(h, testCallback)
+	}()
+	<-started
+	assert.Equal(
+		t, http.StatusConflict, postCallback(h, testCallback),
+		"callbacks still being dispatched should not be acknowledged",
+	)
+	finish <- errors.New("error")
+	assert.Equal(t, http.StatusInternalServerError, <-done)
+	assert.Equal(t, 1, count)
+}
+
+func TestMemoryIdempotencyStore(t *testing.T) {
+	t.Parallel()
+	s := NewMemoryIdempotencyStore()
+	s.now = func() time.Time {
+		return testStart
+	}
+	ok, err := s.Claim("key", testStart.Add(time.Hour))
+	require.Nil(t, err)
+	assert.True(t, ok)
+	ok, _ = s.Claim("key", testStart.Add(time.Hour))
+	assert.False(t, ok)
+	s.now = func() time.Time {
+		return testStart.Add(2 * time.Hour)
+	}
+	ok, _ = s.Claim("key", testStart.Add(3*time.Hour))
+	assert.True(t, ok, "expired keys should be claimable again")
+}
+
+func TestManager_HandleCallback(t *testing.T) {
+	t.Parallel()
+	f := newFakeAPI()
+	f.matches["EUW1_42"] = testMatch()
+	m := newTestManager(f, WithStub())
+	register(t, m)
+	_, err := m.CreateCodes(testCodeParameters(), "final")
+	require.Nil(t, err)
+	var event *CallbackEvent
+	h := NewCallbackHandler(
+		func(e *CallbackEvent) error {
+			event = e
+			return m.HandleCallback(e)
+		}, WithCodeVerifier(m.KnowsCode), WithMatchClient(m.client.Match),
+	)
+	h.now = func() time.Time {
+		return testStart
+	}
+	require.Equal(t, http.StatusOK, postCallback(h, testCallback))
+	require.NotNil(t, event)
+	assert.Nil(t, event.MatchErr)
+	assert.Equal(t, testMatch(), event.Match)
+	lobby, err := m.Lobby("final")
+	require.Nil(t, err)
+	assert.Equal(t, StateGameEnded, lobby.State)
+	require.NotNil(t, lobby.Result)
+	assert.Equal(t, []string{"b", "c"}, lobby.Result.Winners)
+	assert.Equal(t, 1, f.count("/matches/"), "the match requested by the handler should be used")
+
+	assert.Equal(
+		t, http.StatusForbidden, postCallback(h, strings.Replace(testCallback, "CODE1", "CODE2", 1)),
+		"codes not created by the manager should be rejected",
+	)
+}
@@ -0,0 +1,345 @@
+// Package tournament provides a Manager which orchestrates the tournament endpoints of the League of Legends API
+// for running a tournament: registering a provider and tournament, generating codes for bracket matches and
+// tracking the games played with them until the winner is known.
+package tournament
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// Errors returned by the Manager
+var (
+	ErrNotRegistered      = errors.New("no provider and tournament registered")
+	ErrUnknownMatch       = errors.New("no code created for bracket match")
+	ErrDuplicateMatch     = errors.New("code already created for bracket match")
+	ErrNoMatches          = errors.New("no bracket matches given")
+	ErrCodeCountMismatch  = errors.New("number of returned codes does not match number of bracket matches")
+	ErrCodeMismatch       = errors.New("game was not played with the code of the bracket match")
+	ErrUndecidedGame      = errors.New("game has no winning team")
+	ErrMatchWithoutWinner = errors.New("match has no winning team")
+)
+
+// Option configures a Manager
+type Option func(*Manager)
+
+// WithStub uses the tournament-stub endpoints for all requests. The stub endpoints never report finished games, so
+// the end of a game has to be reported with Manager.Complete.
+func WithStub() Option {
+	return func(m *Manager) {
+		m.stub = true
+	}
+}
+
+// WithLogger sets the logger used by the Manager
+func WithLogger(logger log.FieldLogger) Option {
+	return func(m *Manager) {
+		m.logger = logger
+	}
+}
+
+// Manager runs a tournament on top of the tournament endpoints of the League of Legends API.
+// All state is persisted through its Storage so a Manager can be recreated after a restart.
+type Manager struct {
+	client  *lol.Client
+	storage Storage
+	stub    bool
+	logger  log.FieldLogger
+}
+
+// NewManager returns a new Manager using the given client for requests and the storage for persistence
+func NewManager(client *lol.Client, storage Storage, options ...Option) *Manager {
+	m := &Manager{
+		client:  client,
+		storage: storage,
+		logger:  log.StandardLogger(),
+	}
+	for _, opt := range options {
+		opt(m)
+	}
+	return m
+}
+
+// Register registers the provider and the tournament. If they were already registered the stored registration is
+// returned without sending any request. The ProviderID of the tournament parameters is set by Register.
+func (m *Manager) Register(
+	provider *lol.ProviderRegistrationParameters, tournament *lol.TournamentRegistrationParameters,
+) (*Registration, error) {
+	logger := m.log().WithField("method", "Register")
+	registration, err := m.storage.LoadRegistration()
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	if registration != nil && registration.TournamentID != 0 {
+		return registration, nil
+	}
+	if registration == nil {
+		registration = &Registration{}
+	}
+	if registration.ProviderID == 0 {
+		id, err := m.client.Tournament.CreateProvider(provider, m.stub)
+		if err != nil {
+			logger.Debug(err)
+			return nil, err
+		}
+		registration.ProviderID = id
+		// persist the provider right away so it is not registered again if creating the tournament fails
+		if err := m.storage.SaveRegistration(registration); err != nil {
+			logger.Debug(err)
+			return nil, err
+		}
+	}
+	params := *tournament
+	params.ProviderID = registration.ProviderID
+	id, err := m.client.Tournament.Create(&params, m.stub)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	registration.TournamentID = id
+	if err := m.storage.SaveRegistration(registration); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return registration, nil
+}
+
+// CreateCodes creates one tournament code for each of the given bracket matches and returns the new lobbies.
+// The IDs of the bracket matches are chosen by the caller and must be unique within the tournament.
+func (m *Manager) CreateCodes(params *lol.TournamentCodeParameters, matchIDs ...string) ([]*Lobby, error) {
+	logger := m.log().WithField("method", "CreateCodes")
+	if len(matchIDs) == 0 {
+		return nil, ErrNoMatches
+	}
+	registration, err := m.registration()
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	seen := make(map[string]bool, len(matchIDs))
+	for _, matchID := range matchIDs {
+		lobby, err := m.storage.LoadLobby(matchID)
+		if err != nil {
+			logger.Debug(err)
+			return nil, err
+		}
+		if lobby != nil || seen[matchID] {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateMatch, matchID)
+		}
+		seen[matchID] = true
+	}
+	codes, err := m.client.Tournament.CreateCodes(registration.TournamentID, len(matchIDs), params, m.stub)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	if len(codes) != len(matchIDs) {
+		return nil, ErrCodeCountMismatch
+	}
+	lobbies := make([]*Lobby, len(matchIDs))
+	for i, matchID := range matchIDs {
+		lobbies[i] = &Lobby{
+			MatchID: matchID,
+			Code:    codes[i],
+			State:   StatePending,
+		}
+		if err := m.storage.SaveLobby(lobbies[i]); err != nil {
+			logger.Debug(err)
+			return nil, err
+		}
+	}
+	return lobbies, nil
+}
+
+// Lobby returns the stored lobby of the bracket match without polling for updates
+func (m *Manager) Lobby(matchID string) (*Lobby, error) {
+	lobby, err := m.storage.LoadLobby(matchID)
+	if err != nil {
+		return nil, err
+	}
+	if lobby == nil {
+		return nil, ErrUnknownMatch
+	}
+	return lobby, nil
+}
+
+// Poll updates the state of the bracket match from its lobby events. Once the game has started the games played
+// with the code are checked to detect the end of the game, unless the stub endpoints are used. If the game has
+// ended the finished match is resolved to determine the winner. A match which is not yet available in the match
+// endpoints is retried on the next call.
+func (m *Manager) Poll(matchID string) (*Lobby, error) {
+	logger := m.log().WithFields(
+		log.Fields{
+			"method":  "Poll",
+			"matchId": matchID,
+		},
+	)
+	lobby, err := m.Lobby(matchID)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	if lobby.State != StateGameEnded {
+		events, err := m.client.Tournament.ListLobbyEvents(lobby.Code, m.stub)
+		if err != nil {
+			logger.Debug(err)
+			return nil, err
+		}
+		lobby.State = Replay(events.EventList)
+		if lobby.State == StateGameStarted && !m.stub {
+			games, err := m.client.Tournament.ListGames(lobby.Code)
+			if err != nil {
+				logger.Debug(err)
+				return nil, err
+			}
+			if len(games) > 0 {
+				lobby.State = StateGameEnded
+				lobby.Game = games[len(games)-1]
+			}
+		}
+	}
+	if err := m.resolve(lobby, nil, nil); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	if err := m.storage.SaveLobby(lobby); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return lobby, nil
+}
+
+// PollAll polls all bracket matches which do not have a result yet and returns all lobbies
+func (m *Manager) PollAll() ([]*Lobby, error) {
+	lobbies, err := m.storage.ListLobbies()
+	if err != nil {
+		return nil, err
+	}
+	for i, lobby := range lobbies {
+		if lobby.Result != nil {
+			continue
+		}
+		polled, err := m.Poll(lobby.MatchID)
+		if err != nil {
+			return nil, err
+		}
+		lobbies[i] = polled
+	}
+	return lobbies, nil
+}
+
+// Complete marks the game of the bracket match as ended, e.g. after the result was posted to the callback URL of
+// the provider, and resolves the finished match
+func (m *Manager) Complete(matchID string, game *lol.TournamentGame) (*Lobby, error) {
+	return m.complete(matchID, game, nil, nil)
+}
+
+// complete is Complete with the finished match if it was already requested. The match is only requested if both
+// the match and its error are nil.
+func (m *Manager) complete(matchID string, game *lol.TournamentGame, match *lol.Match, matchErr error) (*Lobby, error) {
+	logger := m.log().WithFields(
+		log.Fields{
+			"method":  "Complete",
+			"matchId": matchID,
+		},
+	)
+	lobby, err := m.Lobby(matchID)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	if game.ShortCode != "" && game.ShortCode != lobby.Code {
+		return nil, ErrCodeMismatch
+	}
+	lobby.State = StateGameEnded
+	lobby.Game = game
+	if err := m.resolve(lobby, match, matchErr); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	if err := m.storage.SaveLobby(lobby); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return lobby, nil
+}
+
+// resolve sets the result of an ended game if the finished match is available. The match is requested unless it
+// or the error of its request is given.
+func (m *Manager) resolve(lobby *Lobby, match *lol.Match, matchErr error) error {
+	if lobby.State != StateGameEnded || lobby.Game == nil || lobby.Result != nil {
+		return nil
+	}
+	if len(lobby.Game.WinningTeam) == 0 {
+		return ErrUndecidedGame
+	}
+	id := MatchID(lobby.Game)
+	err := matchErr
+	if match == nil && err == nil {
+		match, err = m.client.Match.Get(id)
+	}
+	if errors.Is(err, api.ErrNotFound) {
+		// the match is not available yet, see lol.MatchAvailabilityDelay
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	result, err := newResult(id, match)
+	if err != nil {
+		return err
+	}
+	lobby.Result = result
+	return nil
+}
+
+func (m *Manager) registration() (*Registration, error) {
+	registration, err := m.storage.LoadRegistration()
+	if err != nil {
+		return nil, err
+	}
+	if registration == nil || registration.TournamentID == 0 {
+		return nil, ErrNotRegistered
+	}
+	return registration, nil
+}
+
+func (m *Manager) log() log.FieldLogger {
+	return m.logger.WithField("category", "tournament manager")
+}
+
+// MatchID returns the ID of the match-v5 API for a game played with a tournament code
+func MatchID(game *lol.TournamentGame) string {
+	return fmt.Sprintf("%s_%d", strings.ToUpper(game.Region), game.GameID)
+}
+
+func newResult(id string, match *lol.Match) (*Result, error) {
+	result := &Result{
+		MatchID: id,
+		Match:   match,
+	}
+	if match.Info == nil {
+		return nil, ErrMatchWithoutWinner
+	}
+	for _, team := range match.Info.Teams {
+		if team.Win {
+			result.WinningTeamID = team.TeamID
+		}
+	}
+	if result.WinningTeamID == 0 {
+		return nil, ErrMatchWithoutWinner
+	}
+	for _, participant := range match.Info.Participants {
+		if participant.TeamID == result.WinningTeamID {
+			result.Winners = append(result.Winners, participant.PUUID)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,257 @@
+package tournament
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// fakeAPI is an in-memory stand-in for the tournament and match endpoints
+type fakeAPI struct {
+	mu          sync.Mutex
+	providers   int
+	tournaments int
+	codes       int
+	events      map[string][]*lol.LobbyEvent
+	games       map[string][]*lol.TournamentGame
+	matches     map[string]*lol.Match
+	requests    []string
+}
+
+func newFakeAPI() *fakeAPI {
+	return &fakeAPI{
+		events:  map[string][]*lol.LobbyEvent{},
+		games:   map[string][]*lol.TournamentGame{},
+		matches: map[string]*lol.Match{},
+	}
+}
+
+func (f *fakeAPI) Do(r *http.Request) (*http.Response, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	path := r.URL.Path
+	f.requests = append(f.requests, path)
+	last := path[strings.LastIndex(path, "/")+1:]
+	var res interface{}
+	switch {
+	case strings.HasSuffix(path, "/providers"):
+		f.providers++
+		res = f.providers
+	case strings.HasSuffix(path, "/tournaments"):
+		f.tournaments++
+		res = 100 + f.tournaments
+	case strings.HasSuffix(path, "/codes"):
+		count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+		var codes []string
+		for i := 0; i < count; i++ {
+			f.codes++
+			codes = append(codes, "CODE"+strconv.Itoa(f.codes))
+		}
+		res = codes
+	case strings.Contains(path, "/lobby-events/by-code/"):
+		res = lol.LobbyEventList{EventList: f.events[last]}
+	case strings.Contains(path, "/games/by-code/"):
+		res = f.games[last]
+		if res == nil {
+			res = []*lol.TournamentGame{}
+		}
+	case strings.Contains(path, "/matches/"):
+		match, ok := f.matches[last]
+		if !ok {
+			return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+		}
+		res = match
+	default:
+		return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+	}
+	buffer, _ := json.Marshal(res)
+	return &http.Response{StatusCode: http.StatusOK, Body: &mock.ResponseBody{Content: buffer}}, nil
+}
+
+func (f *fakeAPI) addEvents(code string, types ...lol.LobbyEventType) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events[code] = append(f.events[code], events(types...)...)
+}
+
+func (f *fakeAPI) count(substr string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var n int
+	for _, path := range f.requests {
+		if strings.Contains(path, substr) {
+			n++
+		}
+	}
+	return n
+}
+
+func newTestManager(f *fakeAPI, options ...Option) *Manager {
+	client := lol.NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", f, logrus.StandardLogger()))
+	return NewManager(client, NewMemoryStorage(), options...)
+}
+
+func testCodeParameters() *lol.TournamentCodeParameters {
+	return &lol.TournamentCodeParameters{
+		SpectatorType: lol.SpectatorTypeAll,
+		TeamSize:      5,
+		PickType:      lol.PickTypeTournamentDraft,
+		MapType:       lol.MapTypeSummonersRift,
+	}
+}
+
+func testMatch() *lol.Match {
+	return &lol.Match{
+		Info: &lol.MatchInfo{
+			Teams: []*lol.Team{{TeamID: 100}, {TeamID: 200, Win: true}},
+			Participants: []*lol.Participant{
+				{PUUID: "a", TeamID: 100},
+				{PUUID: "b", TeamID: 200},
+				{PUUID: "c", TeamID: 200},
+			},
+		},
+	}
+}
+
+func register(t *testing.T, m *Manager) *Registration {
+	registration, err := m.Register(
+		&lol.ProviderRegistrationParameters{URL: "https://example.com", Region: "EUW"},
+		&lol.TournamentRegistrationParameters{Name: "cup"},
+	)
+	require.Nil(t, err)
+	return registration
+}
+
+func TestManager_Register(t *testing.T) {
+	t.Parallel()
+	f := newFakeAPI()
+	m := newTestManager(f, WithStub())
+	_, err := m.CreateCodes(testCodeParameters(), "semi-1")
+	assert.Equal(t, ErrNotRegistered, err)
+	registration := register(t, m)
+	assert.Equal(t, &Registration{ProviderID: 1, TournamentID: 101}, registration)
+	assert.Equal(t, registration, register(t, m))
+	assert.Equal(t, 1, f.count("/tournament-stub/v5/providers"))
+	assert.Equal(t, 1, f.count("/tournament-stub/v5/tournaments"))
+}
+
+func TestManager_CreateCodes(t *testing.T) {
+	t.Parallel()
+	f := newFakeAPI()
+	m := newTestManager(f, WithStub())
+	register(t, m)
+	_, err := m.CreateCodes(testCodeParameters())
+	assert.Equal(t, ErrNoMatches, err)
+	lobbies, err := m.CreateCodes(testCodeParameters(), "semi-1", "semi-2")
+	require.Nil(t, err)
+	require.Len(t, lobbies, 2)
+	assert.Equal(t, &Lobby{MatchID: "semi-1", Code: "CODE1"}, lobbies[0])
+	assert.Equal(t, &Lobby{MatchID: "semi-2", Code: "CODE2"}, lobbies[1])
+	_, err = m.CreateCodes(testCodeParameters(), "final", "semi-2")
+	assert.ErrorIs(t, err, ErrDuplicateMatch)
+	_, err = m.CreateCodes(testCodeParameters(), "final", "final")
+	assert.ErrorIs(t, err, ErrDuplicateMatch)
+	_, err = m.CreateCodes(&lol.TournamentCodeParameters{}, "final")
+	assert.Equal(t, lol.ErrInvalidTeamSize, err)
+	_, err = m.Lobby("final")
+	assert.Equal(t, ErrUnknownMatch, err)
+}
+
+func TestManager_Poll(t *testing.T) {
+	t.Parallel()
+	f := newFakeAPI()
+	m := newTestManager(f)
+	register(t, m)
+	_, err := m.CreateCodes(testCodeParameters(), "final")
+	require.Nil(t, err)
+
+	lobby, err := m.Poll("final")
+	require.Nil(t, err)
+	assert.Equal(t, StatePending, lobby.State)
+
+	f.addEvents("CODE1", lol.LobbyEventTypePracticeGameCreated, lol.LobbyEventTypeChampSelectStarted)
+	lobby, err = m.Poll("final")
+	require.Nil(t, err)
+	assert.Equal(t, StateChampSelect, lobby.State)
+	assert.Equal(t, 0, f.count("/games/by-code/"))
+
+	f.addEvents("CODE1", lol.LobbyEventTypeGameAllocationStarted, lol.LobbyEventTypeGameAllocatedToLSM)
+	lobby, err = m.Poll("final")
+	require.Nil(t, err)
+	assert.Equal(t, StateGameStarted, lobby.State)
+	assert.Equal(t, 1, f.count("/games/by-code/"))
+
+	f.games["CODE1"] = []*lol.TournamentGame{
+		{
+			ShortCode:   "CODE1",
+			GameID:      42,
+			Region:      "euw1",
+			WinningTeam: []*lol.TournamentTeamMember{{PUUID: "b"}, {PUUID: "c"}},
+		},
+	}
+	lobby, err = m.Poll("final")
+	require.Nil(t, err)
+	assert.Equal(t, StateGameEnded, lobby.State)
+	assert.Nil(t, lobby.Result, "match is not available yet")
+
+	f.matches["EUW1_42"] = testMatch()
+	lobbies, err := m.PollAll()
+	require.Nil(t, err)
+	require.Len(t, lobbies, 1)
+	require.NotNil(t, lobbies[0].Result)
+	assert.Equal(t, "EUW1_42", lobbies[0].Result.MatchID)
+	assert.Equal(t, 200, lobbies[0].Result.WinningTeamID)
+	assert.Equal(t, []string{"b", "c"}, lobbies[0].Result.Winners)
+
+	requests := len(f.requests)
+	_, err = m.PollAll()
+	require.Nil(t, err)
+	assert.Equal(t, requests, len(f.requests), "finished matches should not be polled")
+}
+
+func TestManager_Complete(t *testing.T) {
+	t.Parallel()
+	f := newFakeAPI()
+	f.matches["EUW1_42"] = testMatch()
+	m := newTestManager(f, WithStub())
+	register(t, m)
+	_, err := m.CreateCodes(testCodeParameters(), "final")
+	require.Nil(t, err)
+	f.addEvents("CODE1", lol.LobbyEventTypePracticeGameCreated, lol.LobbyEventTypeGameAllocatedToLSM)
+	lobby, err := m.Poll("final")
+	require.Nil(t, err)
+	assert.Equal(t, StateGameStarted, lobby.State)
+	assert.Equal(t, 0, f.count("/games/by-code/"), "stub endpoints have no games")
+
+	_, err = m.Complete("final", &lol.TournamentGame{ShortCode: "OTHER"})
+	assert.Equal(t, ErrCodeMismatch, err)
+	_, err = m.Complete("final", &lol.TournamentGame{ShortCode: "CODE1", GameID: 42, Region: "EUW1"})
+	assert.Equal(t, ErrUndecidedGame, err)
+	lobby, err = m.Complete(
+		"final", &lol.TournamentGame{
+			ShortCode:   "CODE1",
+			GameID:      42,
+			Region:      "EUW1",
+			WinningTeam: []*lol.TournamentTeamMember{{PUUID: "b"}},
+		},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, StateGameEnded, lobby.State)
+	require.NotNil(t, lobby.Result)
+	assert.Equal(t, []string{"b", "c"}, lobby.Result.Winners)
+	stored, err := m.Lobby("final")
+	require.Nil(t, err)
+	assert.Equal(t, lobby, stored)
+}
@@ -0,0 +1,36 @@
+package tournament
+
+import (
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// Registration contains the IDs of the provider and tournament registered by a Manager
+type Registration struct {
+	ProviderID   int `json:"providerId"`
+	TournamentID int `json:"tournamentId"`
+}
+
+// Lobby tracks the game played with the tournament code of a single bracket match
+type Lobby struct {
+	// ID of the bracket match as chosen by the caller of Manager.CreateCodes
+	MatchID string `json:"matchId"`
+	// Tournament code generated for the bracket match
+	Code  string `json:"code"`
+	State State  `json:"state"`
+	// Game played with the code, set once the game has ended
+	Game *lol.TournamentGame `json:"game,omitempty"`
+	// Result of the game, set once the finished match could be resolved
+	Result *Result `json:"result,omitempty"`
+}
+
+// Result is the outcome of a finished tournament game
+type Result struct {
+	// ID of the match in the match-v5 API
+	MatchID string `json:"matchId"`
+	// ID of the team which won the game, either 100 or 200
+	WinningTeamID int `json:"winningTeamId"`
+	// PUUIDs of the players in the winning team
+	Winners []string `json:"winners"`
+	// The finished match
+	Match *lol.Match `json:"match,omitempty"`
+}
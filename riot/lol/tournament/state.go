@@ -0,0 +1,71 @@
+package tournament
+
+import (
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+// State is the state of the game played with a tournament code
+type State int
+
+// All states of a tournament game, in the order they are passed through
+const (
+	// The code was created but no lobby has been created with it yet
+	StatePending State = iota
+	// A lobby was created with the code and players are joining it
+	StateLobbyCreated
+	// Champion select has started
+	StateChampSelect
+	// The game was allocated to a server and has started
+	StateGameStarted
+	// The game has ended and its result is known
+	StateGameEnded
+)
+
+var stateNames = map[State]string{
+	StatePending:      "pending",
+	StateLobbyCreated: "lobby created",
+	StateChampSelect:  "champ select",
+	StateGameStarted:  "game started",
+	StateGameEnded:    "game ended",
+}
+
+func (s State) String() string {
+	if name, ok := stateNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Transition returns the state following the given state after the lobby event occurred.
+// The end of a game is not signaled by lobby events, so StateGameEnded is never returned for a state other than
+// StateGameEnded itself.
+func Transition(state State, event *lol.LobbyEvent) State {
+	if state == StateGameEnded {
+		return state
+	}
+	switch event.EventType {
+	case lol.LobbyEventTypePracticeGameCreated:
+		// a new lobby is created after champ select was dodged
+		return StateLobbyCreated
+	case lol.LobbyEventTypePlayerJoinedGame, lol.LobbyEventTypePlayerSwitchedTeam, lol.LobbyEventTypePlayerQuitGame:
+		if state < StateLobbyCreated {
+			return StateLobbyCreated
+		}
+	case lol.LobbyEventTypeChampSelectStarted, lol.LobbyEventTypeGameAllocationStarted:
+		if state < StateChampSelect {
+			return StateChampSelect
+		}
+	case lol.LobbyEventTypeGameAllocatedToLSM:
+		return StateGameStarted
+	}
+	return state
+}
+
+// Replay returns the state after all given lobby events occurred, starting from StatePending
+func Replay(events []*lol.LobbyEvent) State {
+	state := StatePending
+	for _, event := range events {
+		state = Transition(state, event)
+	}
+	return state
+}
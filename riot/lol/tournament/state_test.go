@@ -0,0 +1,88 @@
+package tournament
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+func events(types ...lol.LobbyEventType) []*lol.LobbyEvent {
+	res := make([]*lol.LobbyEvent, len(types))
+	for i, eventType := range types {
+		res[i] = &lol.LobbyEvent{EventType: eventType}
+	}
+	return res
+}
+
+func TestReplay(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		events []*lol.LobbyEvent
+		want   State
+	}{
+		{
+			name: "no events",
+			want: StatePending,
+		},
+		{
+			name:   "lobby created",
+			events: events(lol.LobbyEventTypePracticeGameCreated, lol.LobbyEventTypePlayerJoinedGame),
+			want:   StateLobbyCreated,
+		},
+		{
+			name: "champ select",
+			events: events(
+				lol.LobbyEventTypePracticeGameCreated, lol.LobbyEventTypePlayerJoinedGame,
+				lol.LobbyEventTypeChampSelectStarted,
+			),
+			want: StateChampSelect,
+		},
+		{
+			name: "dodged champ select",
+			events: events(
+				lol.LobbyEventTypePracticeGameCreated, lol.LobbyEventTypeChampSelectStarted,
+				lol.LobbyEventTypePlayerQuitGame, lol.LobbyEventTypePracticeGameCreated,
+			),
+			want: StateLobbyCreated,
+		},
+		{
+			name: "game started",
+			events: events(
+				lol.LobbyEventTypePracticeGameCreated, lol.LobbyEventTypeChampSelectStarted,
+				lol.LobbyEventTypeGameAllocationStarted, lol.LobbyEventTypeGameAllocatedToLSM,
+			),
+			want: StateGameStarted,
+		},
+		{
+			name:   "unknown event",
+			events: events(lol.LobbyEventTypePracticeGameCreated, "SomethingElse"),
+			want:   StateLobbyCreated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, Replay(tt.events))
+			},
+		)
+	}
+}
+
+func TestTransition(t *testing.T) {
+	t.Parallel()
+	assert.Equal(
+		t, StateGameEnded, Transition(StateGameEnded, &lol.LobbyEvent{EventType: lol.LobbyEventTypePracticeGameCreated}),
+	)
+	assert.Equal(
+		t, StateGameStarted, Transition(StateGameStarted, &lol.LobbyEvent{EventType: lol.LobbyEventTypePlayerQuitGame}),
+	)
+}
+
+func TestState_String(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "champ select", StateChampSelect.String())
+	assert.Equal(t, "unknown", State(-1).String())
+}
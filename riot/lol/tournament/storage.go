@@ -0,0 +1,93 @@
+package tournament
+
+import (
+	"sort"
+	"sync"
+)
+
+// Storage persists the state of a Manager so it survives restarts.
+// Implementations must be safe for concurrent use.
+type Storage interface {
+	// LoadRegistration returns the stored registration or nil if nothing was stored yet
+	LoadRegistration() (*Registration, error)
+	// SaveRegistration stores the registration
+	SaveRegistration(registration *Registration) error
+	// LoadLobby returns the stored lobby for the bracket match or nil if nothing was stored yet
+	LoadLobby(matchID string) (*Lobby, error)
+	// SaveLobby stores the lobby, replacing any lobby stored for the same bracket match
+	SaveLobby(lobby *Lobby) error
+	// ListLobbies returns all stored lobbies
+	ListLobbies() ([]*Lobby, error)
+}
+
+// MemoryStorage is a Storage which keeps everything in memory
+type MemoryStorage struct {
+	mu           sync.RWMutex
+	registration *Registration
+	lobbies      map[string]*Lobby
+}
+
+// NewMemoryStorage returns a new empty MemoryStorage
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		lobbies: map[string]*Lobby{},
+	}
+}
+
+// LoadRegistration implements Storage
+func (s *MemoryStorage) LoadRegistration() (*Registration, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.registration == nil {
+		return nil, nil
+	}
+	registration := *s.registration
+	return &registration, nil
+}
+
+// SaveRegistration implements Storage
+func (s *MemoryStorage) SaveRegistration(registration *Registration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := *registration
+	s.registration = &stored
+	return nil
+}
+
+// LoadLobby implements Storage
+func (s *MemoryStorage) LoadLobby(matchID string) (*Lobby, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	lobby, ok := s.lobbies[matchID]
+	if !ok {
+		return nil, nil
+	}
+	stored := *lobby
+	return &stored, nil
+}
+
+// SaveLobby implements Storage
+func (s *MemoryStorage) SaveLobby(lobby *Lobby) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := *lobby
+	s.lobbies[lobby.MatchID] = &stored
+	return nil
+}
+
+// ListLobbies implements Storage. The lobbies are sorted by their bracket match ID.
+func (s *MemoryStorage) ListLobbies() ([]*Lobby, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]*Lobby, 0, len(s.lobbies))
+	for _, lobby := range s.lobbies {
+		stored := *lobby
+		res = append(res, &stored)
+	}
+	sort.Slice(
+		res, func(i, j int) bool {
+			return res[i].MatchID < res[j].MatchID
+		},
+	)
+	return res, nil
+}
@@ -37,7 +37,7 @@ func TestTournamentClient_CreateCodes(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
-				got, err := (&TournamentClient{c: client}).CreateCodes(0, 0, &TournamentCodeParameters{}, true)
+				got, err := (&TournamentClient{c: client}).CreateCodes(0, 1, validCodeParameters(), true)
 				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
@@ -179,6 +179,46 @@ func TestTournamentClient_Get(t *testing.T) {
 	}
 }
 
+func TestTournamentClient_GetStub(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    *Tournament
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: &Tournament{},
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if r.URL.Path != "/lol/tournament-stub/v5/codes/code" {
+						return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+					}
+					return mock.NewJSONMockDoer(Tournament{}, 200).Do(r)
+				},
+			},
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&TournamentClient{c: client}).GetStub("code")
+				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
 func TestTournamentClient_Update(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -200,9 +240,179 @@ func TestTournamentClient_Update(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
-				err := (&TournamentClient{c: client}).Update("code", TournamentUpdateParameters{})
+				err := (&TournamentClient{c: client}).Update("code", validUpdateParameters())
+				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+			},
+		)
+	}
+}
+
+func TestTournamentClient_ListGames(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    []*TournamentGame
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: []*TournamentGame{{ShortCode: "code", WinningTeam: []*TournamentTeamMember{{PUUID: "puuid"}}}},
+			doer: mock.NewJSONMockDoer(
+				[]*TournamentGame{{ShortCode: "code", WinningTeam: []*TournamentTeamMember{{PUUID: "puuid"}}}}, 200,
+			),
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&TournamentClient{c: client}).ListGames("code")
 				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestTournamentClient_Routing(t *testing.T) {
+	t.Parallel()
+	var hosts []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			hosts = append(hosts, r.URL.Host)
+			return mock.NewJSONMockDoer(Tournament{}, 200).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	tournaments := &TournamentClient{c: client}
+	for i := 0; i < 2; i++ {
+		_, err := tournaments.Get("code")
+		require.Nil(t, err)
+	}
+	assert.Equal(t, []string{"europe.api.riotgames.com", "europe.api.riotgames.com"}, hosts)
+	assert.Equal(t, api.RegionEuropeWest, client.Region, "shared client must not be modified")
+}
+
+func TestTournamentCodeParameters_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		modify  func(p *TournamentCodeParameters)
+		wantErr error
+	}{
+		{
+			name:   "valid",
+			modify: func(p *TournamentCodeParameters) {},
+		},
+		{
+			name:    "team size too small",
+			modify:  func(p *TournamentCodeParameters) { p.TeamSize = 0 },
+			wantErr: ErrInvalidTeamSize,
+		},
+		{
+			name:    "team size too big",
+			modify:  func(p *TournamentCodeParameters) { p.TeamSize = 6 },
+			wantErr: ErrInvalidTeamSize,
+		},
+		{
+			name:    "invalid map",
+			modify:  func(p *TournamentCodeParameters) { p.MapType = "CRYSTAL_SCAR" },
+			wantErr: ErrInvalidMapType,
+		},
+		{
+			name:    "invalid pick type",
+			modify:  func(p *TournamentCodeParameters) { p.PickType = "" },
+			wantErr: ErrInvalidPickType,
+		},
+		{
+			name:    "invalid spectator type",
+			modify:  func(p *TournamentCodeParameters) { p.SpectatorType = "SOME" },
+			wantErr: ErrInvalidSpectatorType,
+		},
+		{
+			name:    "duplicate participant",
+			modify:  func(p *TournamentCodeParameters) { p.AllowedParticipants = []string{"a", "a"} },
+			wantErr: ErrDuplicateParticipant,
+		},
+		{
+			name:    "empty participant",
+			modify:  func(p *TournamentCodeParameters) { p.AllowedParticipants = []string{""} },
+			wantErr: ErrInvalidParticipant,
+		},
+		{
+			name: "too many participants",
+			modify: func(p *TournamentCodeParameters) {
+				p.AllowedParticipants = []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}
+			},
+			wantErr: ErrTooManyParticipants,
+		},
+		{
+			name: "not enough participants",
+			modify: func(p *TournamentCodeParameters) {
+				p.EnoughPlayers = true
+				p.AllowedParticipants = []string{"1", "2", "3"}
+			},
+			wantErr: ErrNotEnoughParticipants,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				params := validCodeParameters()
+				tt.modify(params)
+				assert.Equal(t, tt.wantErr, params.Validate())
 			},
 		)
 	}
+	var params *TournamentCodeParameters
+	assert.Equal(t, ErrNilParameters, params.Validate())
+	var updateParams *TournamentUpdateParameters
+	assert.Equal(t, ErrNilParameters, updateParams.Validate())
+}
+
+func TestTournamentClient_InvalidParameters(t *testing.T) {
+	t.Parallel()
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			t.Error("no request should be sent for invalid parameters")
+			return mock.NewStatusMockDoer(200).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	tournaments := &TournamentClient{c: client}
+	_, err := tournaments.CreateCodes(1, 1, &TournamentCodeParameters{}, true)
+	assert.Equal(t, ErrInvalidTeamSize, err)
+	_, err = tournaments.CreateCodes(1, 0, validCodeParameters(), true)
+	assert.Equal(t, ErrInvalidCodeCount, err)
+	err = tournaments.Update("code", &TournamentUpdateParameters{MapType: MapTypeHowlingAbyss})
+	assert.Equal(t, ErrInvalidPickType, err)
+	_, err = tournaments.CreateCodes(1, 1, nil, true)
+	assert.Equal(t, ErrNilParameters, err)
+	err = tournaments.Update("code", nil)
+	assert.Equal(t, ErrNilParameters, err)
+}
+
+func validCodeParameters() *TournamentCodeParameters {
+	return &TournamentCodeParameters{
+		SpectatorType: SpectatorTypeAll,
+		TeamSize:      5,
+		PickType:      PickTypeTournamentDraft,
+		MapType:       MapTypeSummonersRift,
+	}
+}
+
+func validUpdateParameters() *TournamentUpdateParameters {
+	return &TournamentUpdateParameters{
+		SpectatorType: SpectatorTypeLobbyOnly,
+		PickType:      PickTypeBlindPick,
+		MapType:       MapTypeSummonersRift,
+	}
 }
@@ -0,0 +1,275 @@
+package lol
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// MatchAvailabilityDelay is the usual time after the end of a game until its match is available. Until then
+// MatchClient.Get returns api.ErrNotFound for the match.
+const MatchAvailabilityDelay = 5 * time.Minute
+
+const (
+	defaultRequestsPerSecond  = 1
+	defaultMatchRetryInterval = 30 * time.Second
+	// retry for twice the usual delay before giving up
+	defaultMatchRetries = int(2 * MatchAvailabilityDelay / defaultMatchRetryInterval)
+)
+
+// GameEventType is the type of GameEvent
+type GameEventType int
+
+// All types of game events
+const (
+	// A watched player started a game
+	GameEventStarted GameEventType = iota
+	// A watched player finished a game. The event carries the finished match once it is available.
+	GameEventEnded
+	// A request failed
+	GameEventError
+)
+
+// GameEvent is emitted by a GameWatcher
+type GameEvent struct {
+	Type GameEventType
+	// PUUID of the watched player
+	PUUID string
+	// The ongoing game. For GameEventEnded this is the last known state of the game.
+	Game *GameInfo
+	// The finished match, only set for GameEventEnded
+	Match *Match
+	// The error which occurred. For GameEventEnded the error is set if the match could not be resolved.
+	Error error
+}
+
+// GameWatcher polls the active games of a set of players and emits events when they start or finish a game.
+// Every call to Step sends a single request, Run calls Step at the rate given by RequestsPerSecond, so the watcher
+// stays within a rate limit budget regardless of how many players are watched.
+type GameWatcher struct {
+	// Maximum number of requests sent per second by Run. Values which are not positive and finite fall back to one
+	// request per second.
+	RequestsPerSecond float64
+	// Time between two attempts to fetch the match of a finished game
+	MatchRetryInterval time.Duration
+	// Number of attempts to fetch the match of a finished game before GameEventEnded is emitted with an error
+	MatchRetries int
+
+	client  *Client
+	mu      sync.Mutex
+	puuids  []string
+	next    int
+	games   map[string]*GameInfo
+	pending []*pendingGame
+	now     func() time.Time
+}
+
+// pendingGame is a finished game waiting for its match to become available
+type pendingGame struct {
+	game     *GameInfo
+	puuids   []string
+	attempts int
+	due      time.Time
+	// set while the match is requested, so other players of the game are still added to it
+	resolving bool
+}
+
+// NewGameWatcher returns a new GameWatcher watching the given players and sending at most the given number of
+// requests per second
+func NewGameWatcher(client *Client, requestsPerSecond float64, puuids ...string) *GameWatcher {
+	w := &GameWatcher{
+		RequestsPerSecond:  requestsPerSecond,
+		MatchRetryInterval: defaultMatchRetryInterval,
+		MatchRetries:       defaultMatchRetries,
+		client:             client,
+		games:              map[string]*GameInfo{},
+		now:                time.Now,
+	}
+	w.Add(puuids...)
+	return w
+}
+
+// Add starts watching the given players
+func (w *GameWatcher) Add(puuids ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, puuid := range puuids {
+		if w.indexOf(puuid) == -1 {
+			w.puuids = append(w.puuids, puuid)
+		}
+	}
+}
+
+// Remove stops watching the given players. Games which already ended are still resolved.
+func (w *GameWatcher) Remove(puuids ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, puuid := range puuids {
+		i := w.indexOf(puuid)
+		if i == -1 {
+			continue
+		}
+		w.puuids = append(w.puuids[:i], w.puuids[i+1:]...)
+		if w.next > i {
+			w.next--
+		}
+		delete(w.games, puuid)
+	}
+}
+
+// Run calls Step until the context is done and sends the events to the returned channel, which is closed once
+// the context is done
+func (w *GameWatcher) Run(ctx context.Context) <-chan *GameEvent {
+	events := make(chan *GameEvent, 100)
+	rate := w.RequestsPerSecond
+	if rate <= 0 || math.IsInf(rate, 0) || math.IsNaN(rate) {
+		rate = defaultRequestsPerSecond
+	}
+	interval := max(time.Duration(float64(time.Second)/rate), time.Nanosecond)
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			for _, event := range w.Step() {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events
+}
+
+// Step sends a single request and returns the resulting events. Matches of finished games are fetched first once
+// they are due, otherwise the active game of the next watched player is checked. The watcher is not locked while the
+// request is sent, so Add and Remove do not wait for it.
+func (w *GameWatcher) Step() []*GameEvent {
+	w.mu.Lock()
+	now := w.now()
+	for _, pending := range w.pending {
+		if !pending.resolving && !now.Before(pending.due) {
+			pending.resolving = true
+			w.mu.Unlock()
+			return w.resolve(pending, now)
+		}
+	}
+	if len(w.puuids) == 0 {
+		w.mu.Unlock()
+		return nil
+	}
+	if w.next >= len(w.puuids) {
+		w.next = 0
+	}
+	puuid := w.puuids[w.next]
+	w.next++
+	w.mu.Unlock()
+	return w.check(puuid, now)
+}
+
+func (w *GameWatcher) check(puuid string, now time.Time) []*GameEvent {
+	logger := w.logger().WithField("method", "check")
+	game, err := w.client.Spectator.GetCurrent(puuid)
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
+		logger.Debug(err)
+		return []*GameEvent{{Type: GameEventError, PUUID: puuid, Error: err}}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.indexOf(puuid) == -1 {
+		// the player was removed while the request was sent
+		return nil
+	}
+	previous := w.games[puuid]
+	if previous != nil && game != nil && previous.GameID == game.GameID {
+		w.games[puuid] = game
+		return nil
+	}
+	if previous != nil {
+		delete(w.games, puuid)
+		w.finish(puuid, previous, now)
+	}
+	if game == nil {
+		return nil
+	}
+	w.games[puuid] = game
+	return []*GameEvent{{Type: GameEventStarted, PUUID: puuid, Game: game}}
+}
+
+// finish schedules the match lookup for a finished game, sharing the lookup between players of the same game. The
+// caller has to hold the lock.
+func (w *GameWatcher) finish(puuid string, game *GameInfo, now time.Time) {
+	for _, pending := range w.pending {
+		if pending.game.GameID == game.GameID && pending.game.PlatformID == game.PlatformID {
+			pending.puuids = append(pending.puuids, puuid)
+			return
+		}
+	}
+	w.pending = append(
+		w.pending, &pendingGame{
+			game:   game,
+			puuids: []string{puuid},
+			due:    now,
+		},
+	)
+}
+
+func (w *GameWatcher) resolve(pending *pendingGame, now time.Time) []*GameEvent {
+	logger := w.logger().WithField("method", "resolve")
+	match, err := pending.game.GetMatch(w.client)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	pending.resolving = false
+	pending.attempts++
+	if err != nil {
+		logger.Debug(err)
+		if errors.Is(err, api.ErrNotFound) && pending.attempts < w.MatchRetries {
+			// the match is not available yet, see MatchAvailabilityDelay
+			pending.due = now.Add(w.MatchRetryInterval)
+			return nil
+		}
+	}
+	for i, p := range w.pending {
+		if p == pending {
+			w.pending = append(w.pending[:i], w.pending[i+1:]...)
+			break
+		}
+	}
+	events := make([]*GameEvent, len(pending.puuids))
+	for i, puuid := range pending.puuids {
+		events[i] = &GameEvent{
+			Type:  GameEventEnded,
+			PUUID: puuid,
+			Game:  pending.game,
+			Match: match,
+			Error: err,
+		}
+	}
+	return events
+}
+
+func (w *GameWatcher) indexOf(puuid string) int {
+	for i, p := range w.puuids {
+		if p == puuid {
+			return i
+		}
+	}
+	return -1
+}
+
+func (w *GameWatcher) logger() log.FieldLogger {
+	return w.client.Spectator.c.Logger().WithField("category", "game watcher")
+}
@@ -0,0 +1,200 @@
+package lol
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+// watcherDoer serves the active games and matches set in its maps
+type watcherDoer struct {
+	mu      sync.Mutex
+	games   map[string]*GameInfo
+	matches map[string]*Match
+	status  int
+}
+
+func (d *watcherDoer) Do(r *http.Request) (*http.Response, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.status != 0 {
+		return mock.NewStatusMockDoer(d.status).Do(r)
+	}
+	id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	var res interface{}
+	var ok bool
+	if strings.Contains(r.URL.Path, "/active-games/by-puuid/") {
+		res, ok = d.games[id]
+	} else {
+		res, ok = d.matches[id]
+	}
+	if !ok {
+		return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+	}
+	buffer, _ := json.Marshal(res)
+	return &http.Response{StatusCode: http.StatusOK, Body: &mock.ResponseBody{Content: buffer}}, nil
+}
+
+func (d *watcherDoer) set(f func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	f()
+}
+
+func TestGameWatcher_Step(t *testing.T) {
+	t.Parallel()
+	doer := &watcherDoer{games: map[string]*GameInfo{}, matches: map[string]*Match{}}
+	client := NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+	w := NewGameWatcher(client, 10, "a", "b")
+	w.MatchRetries = 2
+	now := time.Now()
+	w.now = func() time.Time {
+		return now
+	}
+
+	assert.Empty(t, w.Step())
+	assert.Empty(t, w.Step())
+
+	game := &GameInfo{GameID: 1, PlatformID: "EUW1"}
+	doer.set(
+		func() {
+			doer.games["a"] = game
+			doer.games["b"] = game
+		},
+	)
+	assert.Equal(t, []*GameEvent{{Type: GameEventStarted, PUUID: "a", Game: game}}, w.Step())
+	assert.Equal(t, []*GameEvent{{Type: GameEventStarted, PUUID: "b", Game: game}}, w.Step())
+	assert.Empty(t, w.Step(), "ongoing games should not be reported again")
+
+	doer.set(
+		func() {
+			delete(doer.games, "a")
+			delete(doer.games, "b")
+		},
+	)
+	assert.Empty(t, w.Step())
+	assert.Empty(t, w.Step(), "match lookup should be attempted before checking the next player")
+	assert.Empty(t, w.Step())
+	require.Len(t, w.pending, 1)
+	assert.Equal(t, []string{"b", "a"}, w.pending[0].puuids, "players of the same game should share the lookup")
+
+	match := &Match{Metadata: &MatchMetadata{MatchID: "EUW1_1"}}
+	doer.set(
+		func() {
+			doer.matches["EUW1_1"] = match
+		},
+	)
+	now = now.Add(w.MatchRetryInterval)
+	assert.Equal(
+		t, []*GameEvent{
+			{Type: GameEventEnded, PUUID: "b", Game: game, Match: match},
+			{Type: GameEventEnded, PUUID: "a", Game: game, Match: match},
+		}, w.Step(),
+	)
+	assert.Empty(t, w.pending)
+}
+
+func TestGameWatcher_MatchRetries(t *testing.T) {
+	t.Parallel()
+	game := &GameInfo{GameID: 1, PlatformID: "EUW1"}
+	doer := &watcherDoer{games: map[string]*GameInfo{"a": game}, matches: map[string]*Match{}}
+	client := NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+	w := NewGameWatcher(client, 10, "a")
+	w.MatchRetries = 1
+	require.Len(t, w.Step(), 1)
+	doer.set(
+		func() {
+			delete(doer.games, "a")
+		},
+	)
+	assert.Empty(t, w.Step())
+	assert.Equal(
+		t, []*GameEvent{{Type: GameEventEnded, PUUID: "a", Game: game, Error: api.ErrNotFound}}, w.Step(),
+	)
+}
+
+func TestGameWatcher_Error(t *testing.T) {
+	t.Parallel()
+	doer := &watcherDoer{status: http.StatusForbidden}
+	client := NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+	w := NewGameWatcher(client, 10, "a")
+	assert.Equal(t, []*GameEvent{{Type: GameEventError, PUUID: "a", Error: api.ErrForbidden}}, w.Step())
+}
+
+func TestGameWatcher_AddRemove(t *testing.T) {
+	t.Parallel()
+	w := NewGameWatcher(nil, 10, "a", "b")
+	w.Add("b", "c")
+	assert.Equal(t, []string{"a", "b", "c"}, w.puuids)
+	w.next = 2
+	w.Remove("a", "d")
+	assert.Equal(t, []string{"b", "c"}, w.puuids)
+	assert.Equal(t, 1, w.next)
+}
+
+func TestGameWatcher_Run(t *testing.T) {
+	t.Parallel()
+	game := &GameInfo{GameID: 1}
+	doer := &watcherDoer{games: map[string]*GameInfo{"a": game}}
+	client := NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+	ctx, cancel := context.WithCancel(context.Background())
+	events := NewGameWatcher(client, 100, "a").Run(ctx)
+	assert.Equal(t, &GameEvent{Type: GameEventStarted, PUUID: "a", Game: game}, <-events)
+	cancel()
+	for range events {
+	}
+}
+
+func TestGameWatcher_Run_invalidRate(t *testing.T) {
+	t.Parallel()
+	for _, rate := range []float64{0, -1, math.Inf(1), math.NaN()} {
+		game := &GameInfo{GameID: 1}
+		doer := &watcherDoer{games: map[string]*GameInfo{"a": game}}
+		client := NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+		ctx, cancel := context.WithCancel(context.Background())
+		events := NewGameWatcher(client, rate, "a").Run(ctx)
+		assert.Equal(t, &GameEvent{Type: GameEventStarted, PUUID: "a", Game: game}, <-events)
+		cancel()
+		for range events {
+		}
+	}
+}
+
+func TestGameWatcher_Step_unlocked(t *testing.T) {
+	t.Parallel()
+	started, release := make(chan struct{}), make(chan struct{})
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			close(started)
+			<-release
+			return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+		},
+	}
+	client := NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+	w := NewGameWatcher(client, 10, "a")
+	done := make(chan []*GameEvent)
+	go func() {
+		done <- w.Step()
+	}()
+	<-started
+	// Add and Remove must not wait for the request of Step
+	w.Add("b")
+	w.Remove("a")
+	close(release)
+	assert.Empty(t, <-done)
+	assert.Equal(t, []string{"b"}, w.puuids)
+	assert.Empty(t, w.games)
+}
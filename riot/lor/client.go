@@ -1,15 +1,54 @@
 package lor
 
-import "github.com/KnutZuidema/golio/internal"
+import (
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
 
 // Client pools methods for the Legends of Runeterra API.
 type Client struct {
-	Ranked *RankedClient
+	Ranked    *RankedClient
+	Match     *MatchClient
+	Deck      *DeckClient
+	Inventory *InventoryClient
+	Status    *StatusClient
 }
 
 // NewClient returns a new instance of a Legends of Runeterra client.
 func NewClient(base *internal.Client) *Client {
 	return &Client{
-		Ranked: &RankedClient{c: base},
+		Ranked:    &RankedClient{c: base},
+		Match:     &MatchClient{c: base},
+		Deck:      &DeckClient{c: base},
+		Inventory: &InventoryClient{c: base},
+		Status:    &StatusClient{c: base},
 	}
 }
+
+// regionToRoute maps each region to the route serving it. The Legends of Runeterra API is only served by the
+// americas, europe and sea routes, so unlike api.RegionToRoute all Asian regions are mapped to sea.
+var regionToRoute = map[api.Region]api.Route{
+	api.RegionBrasil:            api.RouteAmericas,
+	api.RegionEuropeNorthEast:   api.RouteEurope,
+	api.RegionEuropeWest:        api.RouteEurope,
+	api.RegionJapan:             api.RouteSEA,
+	api.RegionKorea:             api.RouteSEA,
+	api.RegionLatinAmericaNorth: api.RouteAmericas,
+	api.RegionLatinAmericaSouth: api.RouteAmericas,
+	api.RegionMiddleEast:        api.RouteEurope,
+	api.RegionNorthAmerica:      api.RouteAmericas,
+	api.RegionOceania:           api.RouteSEA,
+	api.RegionRussia:            api.RouteEurope,
+	api.RegionSouthEastAsia:     api.RouteSEA,
+	api.RegionTurkey:            api.RouteEurope,
+	api.RegionTaiwan:            api.RouteSEA,
+	api.RegionVietnam:           api.RouteSEA,
+}
+
+// routed returns a copy of the client using the regional route of its region. All endpoints of the Legends of
+// Runeterra API are served by the regional routes.
+func routed(c *internal.Client) *internal.Client {
+	routed := *c
+	routed.Region = api.Region(regionToRoute[c.Region])
+	return &routed
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
@@ -16,3 +17,27 @@ func TestNewClient(t *testing.T) {
 		t.Error("returned nil")
 	}
 }
+
+func TestRouted(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		region api.Region
+		want   api.Region
+	}{
+		{region: api.RegionNorthAmerica, want: "americas"},
+		{region: api.RegionEuropeWest, want: "europe"},
+		{region: api.RegionKorea, want: "sea"},
+		{region: api.RegionJapan, want: "sea"},
+		{region: api.RegionOceania, want: "sea"},
+		{region: api.RegionSouthEastAsia, want: "sea"},
+	}
+	for _, tt := range tests {
+		t.Run(
+			string(tt.region), func(t *testing.T) {
+				c := internal.NewClient(tt.region, "key", mock.NewStatusMockDoer(200), logrus.StandardLogger())
+				assert.Equal(t, tt.want, routed(c).Region)
+				assert.Equal(t, tt.region, c.Region, "client region should not be changed")
+			},
+		)
+	}
+}
@@ -3,4 +3,21 @@ package lor
 const (
 	endpointBase      = "/lor"
 	endpointGetMaster = endpointBase + "/ranked/v1/leaderboards"
+
+	endpointMatchBase          = endpointBase + "/match/v1/matches"
+	endpointGetMatchIDs        = endpointMatchBase + "/by-puuid/%s/ids"
+	endpointGetMatch           = endpointMatchBase + "/%s"
+	endpointDecksByMe          = endpointBase + "/deck/v1/decks/me"
+	endpointCardsByMe          = endpointBase + "/inventory/v1/cards/me"
+	endpointStatusPlatformData = endpointBase + "/status/v1/platform-data"
+)
+
+// GameOutcome is the outcome of a match for a player
+type GameOutcome string
+
+// All possible game outcomes
+const (
+	GameOutcomeWin  GameOutcome = "win"
+	GameOutcomeLoss GameOutcome = "loss"
+	GameOutcomeTie  GameOutcome = "tie"
 )
@@ -0,0 +1,43 @@
+package lor
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// DeckClient provides methods for the deck endpoints of the Legends of Runeterra API. All endpoints require an
+// access token of the player obtained through Riot Sign On.
+type DeckClient struct {
+	c *internal.Client
+}
+
+// List returns the decks of the player the access token was issued for
+func (c *DeckClient) List(authorization string) ([]*Deck, error) {
+	logger := c.logger().WithField("method", "List")
+	var decks []*Deck
+	if err := routed(c.c).GetInto(
+		endpointDecksByMe, &decks, internal.WithHeader("Authorization", authorization),
+	); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return decks, nil
+}
+
+// Create creates a deck for the player the access token was issued for and returns the ID of the new deck.
+func (c *DeckClient) Create(authorization string, deck *NewDeck) (string, error) {
+	logger := c.logger().WithField("method", "Create")
+	var id string
+	if err := routed(c.c).PostInto(
+		endpointDecksByMe, deck, &id, internal.WithHeader("Authorization", authorization),
+	); err != nil {
+		logger.Debug(err)
+		return "", err
+	}
+	return id, nil
+}
+
+func (c *DeckClient) logger() log.FieldLogger {
+	return c.c.Logger().WithField("category", "deck")
+}
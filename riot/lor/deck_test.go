@@ -0,0 +1,86 @@
+package lor
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func authorized(doer internal.Doer) internal.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				return mock.NewStatusMockDoer(http.StatusUnauthorized).Do(r)
+			}
+			return doer.Do(r)
+		},
+	}
+}
+
+func TestDeckClient_List(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    []*Deck
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: []*Deck{{ID: "id", Name: "name", Code: "code"}},
+			doer: authorized(mock.NewJSONMockDoer([]*Deck{{ID: "id", Name: "name", Code: "code"}}, 200)),
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&DeckClient{c: client}).List("Bearer token")
+				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestDeckClient_Create(t *testing.T) {
+	t.Parallel()
+	var body NewDeck
+	doer := authorized(
+		&mock.Doer{
+			Custom: func(r *http.Request) (*http.Response, error) {
+				if r.Method != http.MethodPost {
+					return mock.NewStatusMockDoer(http.StatusMethodNotAllowed).Do(r)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					return nil, err
+				}
+				return mock.NewJSONMockDoer("id", 200).Do(r)
+			},
+		},
+	)
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	got, err := (&DeckClient{c: client}).Create("Bearer token", &NewDeck{Name: "name", Code: "code"})
+	require.Nil(t, err)
+	assert.Equal(t, "id", got)
+	assert.Equal(t, NewDeck{Name: "name", Code: "code"}, body)
+
+	_, err = (&DeckClient{c: client}).Create("", &NewDeck{})
+	assert.Equal(t, api.ErrUnauthorized, err)
+}
@@ -0,0 +1,263 @@
+// Package deckcode encodes and decodes Legends of Runeterra deck codes.
+//
+// A deck code is the unpadded base32 encoding of a format and version byte followed by varints. The cards with a
+// count of three, two and one are stored in groups of cards sharing a set and faction, followed by the cards with
+// any other count.
+package deckcode
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	format = 1
+	// MaxVersion is the highest version of the deck code format supported by the package
+	MaxVersion = 5
+)
+
+var (
+	// ErrInvalidCode is returned if a deck code is not valid base32 or is truncated
+	ErrInvalidCode = errors.New("invalid deck code")
+	// ErrUnknownFormat is returned if a deck code has a format other than 1
+	ErrUnknownFormat = errors.New("unknown deck code format")
+	// ErrUnsupportedVersion is returned if a deck code has a version greater than MaxVersion
+	ErrUnsupportedVersion = errors.New("unsupported deck code version")
+	// ErrUnknownFaction is returned if a deck code or card code contains an unknown faction
+	ErrUnknownFaction = errors.New("unknown faction")
+	// ErrInvalidCardCode is returned if a card code is not of the form 01DE001
+	ErrInvalidCardCode = errors.New("invalid card code")
+	// ErrInvalidCount is returned if a card has a count less than 1
+	ErrInvalidCount = errors.New("card count must be positive")
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Faction is a region of Legends of Runeterra
+type Faction struct {
+	// ID of the faction in deck codes
+	ID int
+	// Code of the faction in card codes
+	Code string
+	// Version of the deck code format which introduced the faction
+	Version int
+}
+
+// Factions contains all factions supported by the package
+var Factions = []Faction{
+	{ID: 0, Code: "DE", Version: 1},
+	{ID: 1, Code: "FR", Version: 1},
+	{ID: 2, Code: "IO", Version: 1},
+	{ID: 3, Code: "NX", Version: 1},
+	{ID: 4, Code: "PZ", Version: 1},
+	{ID: 5, Code: "SI", Version: 1},
+	{ID: 6, Code: "BW", Version: 2},
+	{ID: 7, Code: "SH", Version: 3},
+	{ID: 9, Code: "MT", Version: 2},
+	{ID: 10, Code: "BC", Version: 4},
+	{ID: 12, Code: "RU", Version: 5},
+}
+
+// CardCodeAndCount is a card of a deck and the number of its copies in the deck
+type CardCodeAndCount struct {
+	// Code of the card, e.g. 01DE001
+	CardCode string
+	Count    int
+}
+
+// Deck is a list of cards
+type Deck []CardCodeAndCount
+
+// Decode returns the cards of the deck code
+func Decode(code string) (Deck, error) {
+	data, err := encoding.DecodeString(strings.TrimRight(strings.ToUpper(strings.TrimSpace(code)), "="))
+	if err != nil || len(data) == 0 {
+		return nil, ErrInvalidCode
+	}
+	if data[0]>>4 != format {
+		return nil, ErrUnknownFormat
+	}
+	if data[0]&0xF > MaxVersion {
+		return nil, ErrUnsupportedVersion
+	}
+	r := &reader{data: data[1:]}
+	var deck Deck
+	for count := 3; count > 0; count-- {
+		groups := r.next()
+		for i := 0; i < groups && r.err == nil; i++ {
+			cards, set, faction := r.next(), r.next(), r.next()
+			for j := 0; j < cards && r.err == nil; j++ {
+				card, err := cardCode(set, faction, r.next())
+				if err != nil {
+					return nil, err
+				}
+				deck = append(deck, CardCodeAndCount{CardCode: card, Count: count})
+			}
+		}
+	}
+	for r.err == nil && len(r.data) > 0 {
+		count, set, faction, number := r.next(), r.next(), r.next(), r.next()
+		if r.err != nil {
+			break
+		}
+		card, err := cardCode(set, faction, number)
+		if err != nil {
+			return nil, err
+		}
+		deck = append(deck, CardCodeAndCount{CardCode: card, Count: count})
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return deck, nil
+}
+
+// Encode returns the deck code of the deck. The version of the code is the lowest version supporting all factions
+// of the deck.
+func Encode(deck Deck) (string, error) {
+	version := 1
+	byCount := map[int][]card{}
+	var others []card
+	for _, c := range deck {
+		parsed, err := parseCardCode(c.CardCode)
+		if err != nil {
+			return "", err
+		}
+		if c.Count < 1 {
+			return "", ErrInvalidCount
+		}
+		version = max(version, parsed.faction.Version)
+		parsed.count = c.Count
+		if c.Count <= 3 {
+			byCount[c.Count] = append(byCount[c.Count], parsed)
+		} else {
+			others = append(others, parsed)
+		}
+	}
+	data := []byte{format<<4 | byte(version)}
+	for count := 3; count > 0; count-- {
+		groups := groupCards(byCount[count])
+		data = binary.AppendUvarint(data, uint64(len(groups)))
+		for _, group := range groups {
+			data = binary.AppendUvarint(data, uint64(len(group)))
+			data = binary.AppendUvarint(data, uint64(group[0].set))
+			data = binary.AppendUvarint(data, uint64(group[0].faction.ID))
+			for _, c := range group {
+				data = binary.AppendUvarint(data, uint64(c.number))
+			}
+		}
+	}
+	sort.Slice(
+		others, func(i, j int) bool {
+			return others[i].code < others[j].code
+		},
+	)
+	for _, c := range others {
+		data = binary.AppendUvarint(data, uint64(c.count))
+		data = binary.AppendUvarint(data, uint64(c.set))
+		data = binary.AppendUvarint(data, uint64(c.faction.ID))
+		data = binary.AppendUvarint(data, uint64(c.number))
+	}
+	return encoding.EncodeToString(data), nil
+}
+
+// ParseCardCode returns the set, faction and number of a card code
+func ParseCardCode(code string) (set int, faction Faction, number int, err error) {
+	c, err := parseCardCode(code)
+	if err != nil {
+		return 0, Faction{}, 0, err
+	}
+	return c.set, c.faction, c.number, nil
+}
+
+type card struct {
+	code    string
+	set     int
+	faction Faction
+	number  int
+	count   int
+}
+
+func parseCardCode(code string) (card, error) {
+	if len(code) != 7 {
+		return card{}, fmt.Errorf("%w: %s", ErrInvalidCardCode, code)
+	}
+	set, err := strconv.Atoi(code[:2])
+	if err != nil {
+		return card{}, fmt.Errorf("%w: %s", ErrInvalidCardCode, code)
+	}
+	number, err := strconv.Atoi(code[4:])
+	if err != nil {
+		return card{}, fmt.Errorf("%w: %s", ErrInvalidCardCode, code)
+	}
+	for _, faction := range Factions {
+		if faction.Code == code[2:4] {
+			return card{code: code, set: set, faction: faction, number: number}, nil
+		}
+	}
+	return card{}, fmt.Errorf("%w: %s", ErrUnknownFaction, code[2:4])
+}
+
+func cardCode(set, factionID, number int) (string, error) {
+	for _, faction := range Factions {
+		if faction.ID == factionID {
+			return fmt.Sprintf("%02d%s%03d", set, faction.Code, number), nil
+		}
+	}
+	return "", fmt.Errorf("%w: %d", ErrUnknownFaction, factionID)
+}
+
+// groupCards groups the cards by set and faction. The groups are ordered by their size and then by the code of their
+// first card, the cards of a group by their code.
+func groupCards(cards []card) [][]card {
+	sort.Slice(
+		cards, func(i, j int) bool {
+			return cards[i].code < cards[j].code
+		},
+	)
+	var groups [][]card
+	index := map[string]int{}
+	for _, c := range cards {
+		key := c.code[:4]
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], c)
+	}
+	sort.SliceStable(
+		groups, func(i, j int) bool {
+			if len(groups[i]) != len(groups[j]) {
+				return len(groups[i]) < len(groups[j])
+			}
+			return groups[i][0].code < groups[j][0].code
+		},
+	)
+	return groups
+}
+
+// reader reads varints until the first error
+type reader struct {
+	data []byte
+	err  error
+}
+
+func (r *reader) next() int {
+	if r.err != nil {
+		return 0
+	}
+	value, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = ErrInvalidCode
+		return 0
+	}
+	r.data = r.data[n:]
+	return int(value)
+}
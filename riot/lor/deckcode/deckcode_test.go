@@ -0,0 +1,295 @@
+package deckcode
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sorted(deck Deck) Deck {
+	sort.Slice(
+		deck, func(i, j int) bool {
+			return deck[i].CardCode < deck[j].CardCode
+		},
+	)
+	return deck
+}
+
+// TestDecode_Known decodes codes of all versions of the format. The codes of version 1 were created by the game, the
+// others contain the factions introduced by their version. Groups of the same size are not ordered consistently by
+// the game, so encoded decks are compared by their cards.
+func TestDecode_Known(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		code string
+		want Deck
+	}{
+		{
+			name: "version 1",
+			code: "CEAAECABAQJRWHBIFU2DOOYIAEBAMCIMCINCILJZAICACBANE4VCYBABAILR2HRL",
+			want: Deck{
+				{CardCode: "01PZ019", Count: 2}, {CardCode: "01PZ027", Count: 2}, {CardCode: "01PZ028", Count: 2},
+				{CardCode: "01PZ040", Count: 2}, {CardCode: "01PZ045", Count: 2}, {CardCode: "01PZ052", Count: 2},
+				{CardCode: "01PZ055", Count: 2}, {CardCode: "01PZ059", Count: 2}, {CardCode: "01IO006", Count: 2},
+				{CardCode: "01IO009", Count: 2}, {CardCode: "01IO012", Count: 2}, {CardCode: "01IO018", Count: 2},
+				{CardCode: "01IO026", Count: 2}, {CardCode: "01IO036", Count: 2}, {CardCode: "01IO045", Count: 2},
+				{CardCode: "01IO057", Count: 2}, {CardCode: "01PZ013", Count: 1}, {CardCode: "01PZ039", Count: 1},
+				{CardCode: "01PZ042", Count: 1}, {CardCode: "01PZ044", Count: 1}, {CardCode: "01IO023", Count: 1},
+				{CardCode: "01IO029", Count: 1}, {CardCode: "01IO030", Count: 1}, {CardCode: "01IO043", Count: 1},
+			},
+		},
+		{
+			name: "version 1 with groups of three",
+			code: "CEBAIAIFB4WDANQIAEAQGDAUDAQSIJZUAIAQCBIFAEAQCBAA",
+			want: Deck{
+				{CardCode: "01SI015", Count: 3}, {CardCode: "01SI044", Count: 3}, {CardCode: "01SI048", Count: 3},
+				{CardCode: "01SI054", Count: 3}, {CardCode: "01FR003", Count: 3}, {CardCode: "01FR012", Count: 3},
+				{CardCode: "01FR020", Count: 3}, {CardCode: "01FR024", Count: 3}, {CardCode: "01FR033", Count: 3},
+				{CardCode: "01FR036", Count: 3}, {CardCode: "01FR039", Count: 3}, {CardCode: "01FR052", Count: 3},
+				{CardCode: "01SI005", Count: 2}, {CardCode: "01FR004", Count: 2},
+			},
+		},
+		{
+			name: "version 2 bilgewater",
+			code: "CIAQCAQGBIAQCAQGAMAAIAIAAICQCAAE",
+			want: Deck{
+				{CardCode: "02BW010", Count: 3}, {CardCode: "02BW003", Count: 2}, {CardCode: "01DE002", Count: 4},
+				{CardCode: "01DE004", Count: 5},
+			},
+		},
+		{
+			name: "version 2 mount targon",
+			code: "CIAQCAYJBIAQCAYJAMAAIAIAAICQEBQE",
+			want: Deck{
+				{CardCode: "03MT010", Count: 3}, {CardCode: "03MT003", Count: 2}, {CardCode: "01DE002", Count: 4},
+				{CardCode: "02BW004", Count: 5},
+			},
+		},
+		{
+			name: "version 3 shurima",
+			code: "CMBACAQGBIAQIBYBAEAQEBQDAEAQIB4CAECACAAC",
+			want: Deck{
+				{CardCode: "02BW010", Count: 3}, {CardCode: "04SH001", Count: 3}, {CardCode: "02BW003", Count: 2},
+				{CardCode: "04SH130", Count: 1}, {CardCode: "01DE002", Count: 4},
+			},
+		},
+		{
+			name: "version 4 bandle city",
+			code: "CQAQCBIKAQAQCAQGAMAQCBIKBMCACAAC",
+			want: Deck{
+				{CardCode: "05BC004", Count: 3}, {CardCode: "02BW003", Count: 2}, {CardCode: "05BC011", Count: 1},
+				{CardCode: "01DE002", Count: 4},
+			},
+		},
+		{
+			name: "version 5 runeterra",
+			code: "CUAQCAIAAIAQCAYJAMAQCBQMAECQMDAZ",
+			want: Deck{
+				{CardCode: "01DE002", Count: 3}, {CardCode: "03MT003", Count: 2}, {CardCode: "06RU001", Count: 1},
+				{CardCode: "06RU025", Count: 5},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				deck, err := Decode(tt.code)
+				require.Nil(t, err)
+				assert.Equal(t, tt.want, deck)
+				encoded, err := Encode(deck)
+				require.Nil(t, err)
+				got, err := Decode(encoded)
+				require.Nil(t, err)
+				assert.Equal(t, sorted(deck), sorted(got))
+			},
+		)
+	}
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		deck        Deck
+		wantVersion byte
+	}{
+		{
+			name: "single card",
+			deck: Deck{{CardCode: "01DE002", Count: 1}},
+		},
+		{
+			name: "all counts",
+			deck: Deck{
+				{CardCode: "01DE002", Count: 3},
+				{CardCode: "01DE003", Count: 3},
+				{CardCode: "02IO008", Count: 3},
+				{CardCode: "01NX020", Count: 2},
+				{CardCode: "01PZ001", Count: 1},
+				{CardCode: "01SI031", Count: 1},
+				{CardCode: "01FR024", Count: 2},
+			},
+		},
+		{
+			name: "more than three copies",
+			deck: Deck{
+				{CardCode: "01DE002", Count: 4},
+				{CardCode: "02BW003", Count: 7},
+				{CardCode: "01DE004", Count: 3},
+			},
+			wantVersion: 2,
+		},
+		{
+			name:        "bilgewater and mount targon",
+			deck:        Deck{{CardCode: "02BW032", Count: 3}, {CardCode: "03MT003", Count: 2}},
+			wantVersion: 2,
+		},
+		{
+			name:        "shurima",
+			deck:        Deck{{CardCode: "04SH130", Count: 3}},
+			wantVersion: 3,
+		},
+		{
+			name:        "bandle city",
+			deck:        Deck{{CardCode: "05BC011", Count: 1}},
+			wantVersion: 4,
+		},
+		{
+			name:        "runeterra",
+			deck:        Deck{{CardCode: "06RU001", Count: 1}, {CardCode: "01DE001", Count: 3}},
+			wantVersion: 5,
+		},
+		{
+			name: "large card number",
+			deck: Deck{{CardCode: "01DE999", Count: 2}, {CardCode: "99IO200", Count: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				code, err := Encode(tt.deck)
+				require.Nil(t, err)
+				data, err := encoding.DecodeString(code)
+				require.Nil(t, err)
+				assert.Equal(t, byte(format<<4)|max(1, tt.wantVersion), data[0])
+				got, err := Decode(code)
+				require.Nil(t, err)
+				assert.Equal(t, sorted(append(Deck{}, tt.deck...)), sorted(got))
+			},
+		)
+	}
+}
+
+func TestEncode_OrderIndependent(t *testing.T) {
+	t.Parallel()
+	a, err := Encode(
+		Deck{{CardCode: "01DE002", Count: 3}, {CardCode: "01IO001", Count: 3}, {CardCode: "01DE001", Count: 3}},
+	)
+	require.Nil(t, err)
+	b, err := Encode(
+		Deck{{CardCode: "01IO001", Count: 3}, {CardCode: "01DE001", Count: 3}, {CardCode: "01DE002", Count: 3}},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, a, b)
+}
+
+func TestEncode_Errors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		deck    Deck
+		wantErr error
+	}{
+		{
+			name:    "short card code",
+			deck:    Deck{{CardCode: "01DE01", Count: 1}},
+			wantErr: ErrInvalidCardCode,
+		},
+		{
+			name:    "invalid set",
+			deck:    Deck{{CardCode: "XXDE001", Count: 1}},
+			wantErr: ErrInvalidCardCode,
+		},
+		{
+			name:    "unknown faction",
+			deck:    Deck{{CardCode: "01XX001", Count: 1}},
+			wantErr: ErrUnknownFaction,
+		},
+		{
+			name:    "invalid count",
+			deck:    Deck{{CardCode: "01DE001", Count: 0}},
+			wantErr: ErrInvalidCount,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				_, err := Encode(tt.deck)
+				assert.ErrorIs(t, err, tt.wantErr)
+			},
+		)
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		code    string
+		data    []byte
+		wantErr error
+	}{
+		{
+			name:    "not base32",
+			code:    "not a deck code!",
+			wantErr: ErrInvalidCode,
+		},
+		{
+			name:    "empty",
+			wantErr: ErrInvalidCode,
+		},
+		{
+			name:    "unknown format",
+			data:    []byte{0x21, 0, 0, 0},
+			wantErr: ErrUnknownFormat,
+		},
+		{
+			name:    "unsupported version",
+			data:    []byte{0x16, 0, 0, 0},
+			wantErr: ErrUnsupportedVersion,
+		},
+		{
+			name:    "truncated",
+			data:    []byte{0x11, 1, 1, 1},
+			wantErr: ErrInvalidCode,
+		},
+		{
+			name:    "unknown faction",
+			data:    []byte{0x11, 1, 1, 1, 8, 1},
+			wantErr: ErrUnknownFaction,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				code := tt.code
+				if tt.data != nil {
+					code = encoding.EncodeToString(tt.data)
+				}
+				_, err := Decode(code)
+				assert.ErrorIs(t, err, tt.wantErr)
+			},
+		)
+	}
+}
+
+func TestParseCardCode(t *testing.T) {
+	t.Parallel()
+	set, faction, number, err := ParseCardCode("04SH130")
+	require.Nil(t, err)
+	assert.Equal(t, 4, set)
+	assert.Equal(t, Faction{ID: 7, Code: "SH", Version: 3}, faction)
+	assert.Equal(t, 130, number)
+}
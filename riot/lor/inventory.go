@@ -0,0 +1,30 @@
+package lor
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// InventoryClient provides methods for the inventory endpoints of the Legends of Runeterra API. All endpoints
+// require an access token of the player obtained through Riot Sign On.
+type InventoryClient struct {
+	c *internal.Client
+}
+
+// ListCards returns the cards owned by the player the access token was issued for
+func (c *InventoryClient) ListCards(authorization string) ([]*Card, error) {
+	logger := c.logger().WithField("method", "ListCards")
+	var cards []*Card
+	if err := routed(c.c).GetInto(
+		endpointCardsByMe, &cards, internal.WithHeader("Authorization", authorization),
+	); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return cards, nil
+}
+
+func (c *InventoryClient) logger() log.FieldLogger {
+	return c.c.Logger().WithField("category", "inventory")
+}
@@ -0,0 +1,48 @@
+package lor
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestInventoryClient_ListCards(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    []*Card
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: []*Card{{Code: "01DE001", Count: 3}},
+			doer: authorized(mock.NewJSONMockDoer([]map[string]string{{"code": "01DE001", "count": "3"}}, 200)),
+		},
+		{
+			name:    "unauthorized",
+			wantErr: api.ErrUnauthorized,
+			doer:    mock.NewStatusMockDoer(http.StatusUnauthorized),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&InventoryClient{c: client}).ListCards("Bearer token")
+				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
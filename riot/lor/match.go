@@ -0,0 +1,40 @@
+package lor
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// MatchClient provides methods for the match endpoints of the Legends of Runeterra API.
+type MatchClient struct {
+	c *internal.Client
+}
+
+// ListIDs returns the IDs of the most recent matches of the player with the given PUUID
+func (c *MatchClient) ListIDs(puuid string) ([]string, error) {
+	logger := c.logger().WithField("method", "ListIDs")
+	var ids []string
+	if err := routed(c.c).GetInto(fmt.Sprintf(endpointGetMatchIDs, puuid), &ids); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Get returns the match with the given ID
+func (c *MatchClient) Get(id string) (*Match, error) {
+	logger := c.logger().WithField("method", "Get")
+	var match *Match
+	if err := routed(c.c).GetInto(fmt.Sprintf(endpointGetMatch, id), &match); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return match, nil
+}
+
+func (c *MatchClient) logger() log.FieldLogger {
+	return c.c.Logger().WithField("category", "match")
+}
@@ -0,0 +1,99 @@
+package lor
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestMatchClient_ListIDs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    []string
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: []string{"id"},
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					if r.URL.Host+r.URL.Path != "americas.api.riotgames.com/lor/match/v1/matches/by-puuid/puuid/ids" {
+						return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+					}
+					return mock.NewJSONMockDoer([]string{"id"}, 200).Do(r)
+				},
+			},
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionNorthAmerica, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&MatchClient{c: client}).ListIDs("puuid")
+				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestMatchClient_Get(t *testing.T) {
+	t.Parallel()
+	match := &Match{
+		Metadata: MatchMetadata{MatchID: "id", Participants: []string{"a", "b"}},
+		Info: MatchInfo{
+			GameMode: "Constructed",
+			Players: []*MatchPlayer{
+				{PUUID: "a", DeckID: "deck", DeckCode: "code", GameOutcome: GameOutcomeWin},
+				{PUUID: "b", GameOutcome: GameOutcomeLoss, OrderOfPlay: 1},
+			},
+			TotalTurnCount: 12,
+		},
+	}
+	tests := []struct {
+		name    string
+		want    *Match
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: match,
+			doer: mock.NewJSONMockDoer(match, 200),
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&MatchClient{c: client}).Get("id")
+				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
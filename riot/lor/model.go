@@ -1,8 +1,132 @@
 package lor
 
+import (
+	"github.com/KnutZuidema/golio/riot/lor/deckcode"
+)
+
 // Player represents a ranked Legends of Runeterra player.
 type Player struct {
 	Name         string `json:"name"`
 	Rank         int    `json:"rank"`
 	LeaguePoints int    `json:"lp"`
 }
+
+// Leaderboard contains the players of the Master tier of a region
+type Leaderboard struct {
+	Players []*Player `json:"players"`
+}
+
+// Match contains information about a Legends of Runeterra match
+type Match struct {
+	Metadata MatchMetadata `json:"metadata"`
+	Info     MatchInfo     `json:"info"`
+}
+
+// MatchMetadata contains the ID and the participants of a match
+type MatchMetadata struct {
+	// Version of the match data
+	DataVersion string `json:"data_version"`
+	MatchID     string `json:"match_id"`
+	// PUUIDs of the participants
+	Participants []string `json:"participants"`
+}
+
+// MatchInfo contains the details of a match
+type MatchInfo struct {
+	// (Legal values: Constructed, Expeditions, Tutorial)
+	GameMode string `json:"game_mode"`
+	// (Legal values: Ranked, Normal, AI, Tutorial, VanillaTrial, Singleton, StandardGauntlet)
+	GameType         string         `json:"game_type"`
+	GameStartTimeUTC string         `json:"game_start_time_utc"`
+	GameVersion      string         `json:"game_version"`
+	Players          []*MatchPlayer `json:"players"`
+	// Total turns taken by both players
+	TotalTurnCount int `json:"total_turn_count"`
+}
+
+// MatchPlayer contains information about a player of a match
+type MatchPlayer struct {
+	PUUID  string `json:"puuid"`
+	DeckID string `json:"deck_id"`
+	// Code of the deck the player played, see package deckcode
+	DeckCode    string      `json:"deck_code"`
+	Factions    []string    `json:"factions"`
+	GameOutcome GameOutcome `json:"game_outcome"`
+	// The order in which the players took turns, starting with 0
+	OrderOfPlay int `json:"order_of_play"`
+}
+
+// Cards decodes the deck code of the deck the player played
+func (p *MatchPlayer) Cards() (deckcode.Deck, error) {
+	return deckcode.Decode(p.DeckCode)
+}
+
+// Deck is a deck of a player
+type Deck struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Code of the deck, see package deckcode
+	Code string `json:"code"`
+}
+
+// Cards decodes the code of the deck
+func (d *Deck) Cards() (deckcode.Deck, error) {
+	return deckcode.Decode(d.Code)
+}
+
+// NewDeck contains the information required to create a deck
+type NewDeck struct {
+	Name string `json:"name"`
+	// Code of the deck, see package deckcode
+	Code string `json:"code"`
+}
+
+// Card is a card owned by a player
+type Card struct {
+	Code  string `json:"code"`
+	Count int    `json:"count,string"`
+}
+
+// PlatformData contains the status of Legends of Runeterra for a region
+type PlatformData struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	Locales      []string          `json:"locales"`
+	Maintenances []*PlatformStatus `json:"maintenances"`
+	Incidents    []*PlatformStatus `json:"incidents"`
+}
+
+// PlatformStatus contains information about a maintenance or an incident
+type PlatformStatus struct {
+	ID int `json:"id"`
+	// (Legal values: scheduled, in_progress, complete)
+	MaintenanceStatus string `json:"maintenance_status"`
+	// (Legal values: info, warning, critical)
+	IncidentSeverity string             `json:"incident_severity"`
+	Titles           []*PlatformContent `json:"titles"`
+	Updates          []*PlatformUpdate  `json:"updates"`
+	CreatedAt        string             `json:"created_at"`
+	ArchiveAt        string             `json:"archive_at"`
+	UpdatedAt        string             `json:"updated_at"`
+	// (Legal values: windows, macos, android, ios, ps4, xbone, switch)
+	Platforms []string `json:"platforms"`
+}
+
+// PlatformContent is a localized text of a platform status
+type PlatformContent struct {
+	Locale  string `json:"locale"`
+	Content string `json:"content"`
+}
+
+// PlatformUpdate is an update of a platform status
+type PlatformUpdate struct {
+	ID     int    `json:"id"`
+	Author string `json:"author"`
+	// Whether the update is published
+	Publish bool `json:"publish"`
+	// (Legal values: riotclient, riotstatus, game)
+	PublishLocations []string           `json:"publish_locations"`
+	Translations     []*PlatformContent `json:"translations"`
+	CreatedAt        string             `json:"created_at"`
+	UpdatedAt        string             `json:"updated_at"`
+}
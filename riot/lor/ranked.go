@@ -1,6 +1,10 @@
 package lor
 
-import "github.com/KnutZuidema/golio/internal"
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/internal"
+)
 
 // RankedClient provides methods for the ranked endpoints of the Legends of Runeterra API.
 type RankedClient struct {
@@ -9,9 +13,15 @@ type RankedClient struct {
 
 // GetMasters returns all players currently in the Master tier for the region.
 func (c *RankedClient) GetMasters() ([]*Player, error) {
-	var players []*Player
-	if err := c.c.GetInto(endpointGetMaster, &players); err != nil {
+	logger := c.logger().WithField("method", "GetMasters")
+	var leaderboard Leaderboard
+	if err := routed(c.c).GetInto(endpointGetMaster, &leaderboard); err != nil {
+		logger.Debug(err)
 		return nil, err
 	}
-	return players, nil
+	return leaderboard.Players, nil
+}
+
+func (c *RankedClient) logger() log.FieldLogger {
+	return c.c.Logger().WithField("category", "ranked")
 }
@@ -25,7 +25,7 @@ func TestRankedClient_GetMasters(t *testing.T) {
 		{
 			name: "get response",
 			want: []*Player{},
-			doer: mock.NewJSONMockDoer([]*Player{}, 200),
+			doer: mock.NewJSONMockDoer(Leaderboard{Players: []*Player{}}, 200),
 		},
 		{
 			name: "unknown error status",
@@ -54,3 +54,20 @@ func TestRankedClient_GetMasters(t *testing.T) {
 		)
 	}
 }
+
+func TestRankedClient_GetMastersRouting(t *testing.T) {
+	t.Parallel()
+	var host string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			host = r.URL.Host
+			return mock.NewJSONMockDoer(Leaderboard{Players: []*Player{{Name: "name", Rank: 1}}}, 200).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	got, err := (&RankedClient{c: client}).GetMasters()
+	require.Nil(t, err)
+	assert.Equal(t, []*Player{{Name: "name", Rank: 1}}, got)
+	assert.Equal(t, "europe.api.riotgames.com", host)
+	assert.Equal(t, api.RegionEuropeWest, client.Region, "client region should not be changed")
+}
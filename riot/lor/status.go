@@ -0,0 +1,27 @@
+package lor
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// StatusClient provides methods for the status endpoints of the Legends of Runeterra API.
+type StatusClient struct {
+	c *internal.Client
+}
+
+// GetPlatformData returns the Legends of Runeterra status for the region
+func (c *StatusClient) GetPlatformData() (*PlatformData, error) {
+	logger := c.logger().WithField("method", "GetPlatformData")
+	var data *PlatformData
+	if err := routed(c.c).GetInto(endpointStatusPlatformData, &data); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return data, nil
+}
+
+func (c *StatusClient) logger() log.FieldLogger {
+	return c.c.Logger().WithField("category", "status")
+}
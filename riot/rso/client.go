@@ -0,0 +1,200 @@
+// Package rso implements the authorization code flow of Riot Sign-On (RSO), Riot's OAuth2 provider.
+// It builds authorization URLs, exchanges and refreshes tokens and decodes ID token claims. The resulting tokens
+// can be used for all endpoints of the Riot API which require an access token.
+package rso
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// Client provides the methods of the Riot Sign-On authorization code flow
+type Client struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+	Scopes       []Scope
+	baseURL      string
+	client       internal.Doer
+	logger       log.FieldLogger
+	now          func() time.Time
+}
+
+// Option is used to alter the attributes of a client
+type Option func(*Client)
+
+// WithClient sets the given http client for the RSO client
+func WithClient(c internal.Doer) Option {
+	return func(client *Client) {
+		client.client = c
+	}
+}
+
+// WithLogger sets the given logger for the RSO client
+func WithLogger(l log.FieldLogger) Option {
+	return func(client *Client) {
+		client.logger = l
+	}
+}
+
+// WithBaseURL sets the URL of the authorization server. This is useful for testing against a local server.
+func WithBaseURL(u string) Option {
+	return func(client *Client) {
+		client.baseURL = strings.TrimSuffix(u, "/")
+	}
+}
+
+// WithScopes sets the scopes requested during authorization. Defaults to the openid scope.
+func WithScopes(scopes ...Scope) Option {
+	return func(client *Client) {
+		client.Scopes = scopes
+	}
+}
+
+// NewClient returns a new client for the Riot Sign-On service
+func NewClient(clientID, clientSecret, redirectURI string, options ...Option) *Client {
+	c := &Client{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURI:  redirectURI,
+		Scopes:       []Scope{ScopeOpenID},
+		baseURL:      defaultBaseURL,
+		client:       http.DefaultClient,
+		logger:       log.StandardLogger(),
+		now:          time.Now,
+	}
+	for _, opt := range options {
+		opt(c)
+	}
+	c.logger = c.logger.WithField("client", "rso")
+	return c
+}
+
+// AuthorizationURL returns the URL the player has to be redirected to in order to sign in.
+// The state is passed back to the redirect URI unchanged and should be used to prevent cross-site request forgery.
+func (c *Client) AuthorizationURL(state string) string {
+	scopes := make([]string, 0, len(c.Scopes))
+	for _, scope := range c.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	values := url.Values{
+		"client_id":     {c.ClientID},
+		"redirect_uri":  {c.RedirectURI},
+		"response_type": {"code"},
+		"scope":         {strings.Join(scopes, " ")},
+	}
+	if state != "" {
+		values.Set("state", state)
+	}
+	return c.baseURL + endpointAuthorize + "?" + values.Encode()
+}
+
+// Exchange exchanges the authorization code received at the redirect URI for a token
+func (c *Client) Exchange(code string) (*Token, error) {
+	logger := c.logger.WithField("method", "Exchange")
+	token, err := c.requestToken(
+		url.Values{
+			"grant_type":   {grantAuthorizationCode},
+			"code":         {code},
+			"redirect_uri": {c.RedirectURI},
+		},
+	)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return token, nil
+}
+
+// Refresh requests a new token using the given refresh token
+func (c *Client) Refresh(refreshToken string) (*Token, error) {
+	logger := c.logger.WithField("method", "Refresh")
+	token, err := c.requestToken(
+		url.Values{
+			"grant_type":    {grantRefreshToken},
+			"refresh_token": {refreshToken},
+		},
+	)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	return token, nil
+}
+
+// TokenSource returns a TokenSource which returns the given token as long as it is valid and refreshes it
+// afterwards. The returned TokenSource is safe for concurrent use.
+func (c *Client) TokenSource(token *Token) TokenSource {
+	return &refreshingTokenSource{
+		client: c,
+		token:  token,
+	}
+}
+
+func (c *Client) requestToken(values url.Values) (*Token, error) {
+	request, err := http.NewRequest(
+		http.MethodPost, c.baseURL+endpointToken, strings.NewReader(values.Encode()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	request.SetBasicAuth(url.QueryEscape(c.ClientID), url.QueryEscape(c.ClientSecret))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		rsoErr := &Error{StatusCode: response.StatusCode}
+		// the error body is optional, an undecodable body still results in an error with the status code
+		_ = json.NewDecoder(response.Body).Decode(rsoErr)
+		return nil, rsoErr
+	}
+	var token Token
+	if err := json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("rso: response contains no access token")
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = c.now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return &token, nil
+}
+
+// DecodeIDToken decodes the claims of an ID token.
+// NOTE: the signature of the token is not verified. Only use this for tokens received directly from the
+// authorization server over TLS, e.g. the result of Client.Exchange.
+func DecodeIDToken(idToken string) (*IDTokenClaims, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedIDToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, ErrMalformedIDToken
+	}
+	var claims IDTokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrMalformedIDToken
+	}
+	if err := json.Unmarshal(payload, &claims.Raw); err != nil {
+		return nil, ErrMalformedIDToken
+	}
+	return &claims, nil
+}
@@ -0,0 +1,234 @@
+package rso
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID     = "client-id"
+	testClientSecret = "client-secret"
+	testRedirectURI  = "https://example.com/callback"
+)
+
+// newTestServer starts a local stand-in for the RSO authorization server which accepts the code "code" and the
+// refresh token "refresh"
+func newTestServer(t *testing.T, refreshCount *int32) *httptest.Server {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != endpointToken || r.Method != http.MethodPost {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				id, secret, ok := r.BasicAuth()
+				if !ok || id != testClientID || secret != testClientSecret {
+					w.WriteHeader(http.StatusUnauthorized)
+					_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+					return
+				}
+				if err := r.ParseForm(); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				switch {
+				case r.PostForm.Get("grant_type") == grantAuthorizationCode && r.PostForm.Get("code") == "code" &&
+					r.PostForm.Get("redirect_uri") == testRedirectURI:
+					_ = json.NewEncoder(w).Encode(
+						Token{
+							AccessToken:  "access",
+							RefreshToken: "refresh",
+							IDToken:      testIDToken(t),
+							TokenType:    "Bearer",
+							Scope:        "openid",
+							ExpiresIn:    3600,
+						},
+					)
+				case r.PostForm.Get("grant_type") == grantRefreshToken && r.PostForm.Get("refresh_token") == "refresh":
+					if refreshCount != nil {
+						atomic.AddInt32(refreshCount, 1)
+					}
+					_ = json.NewEncoder(w).Encode(Token{AccessToken: "refreshed", TokenType: "Bearer", ExpiresIn: 3600})
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(
+						map[string]string{"error": "invalid_grant", "error_description": "unknown grant"},
+					)
+				}
+			},
+		),
+	)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func testIDToken(t *testing.T) string {
+	payload, err := json.Marshal(
+		map[string]interface{}{
+			"sub": "puuid",
+			"iss": "https://auth.riotgames.com",
+			"aud": testClientID,
+			"exp": 2000000000,
+			"iat": 1000000000,
+		},
+	)
+	require.Nil(t, err)
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+func newTestClient(server *httptest.Server) *Client {
+	return NewClient(
+		testClientID, testClientSecret, testRedirectURI,
+		WithBaseURL(server.URL), WithClient(server.Client()), WithScopes(ScopeOpenID, ScopeOfflineAccess),
+	)
+}
+
+func TestClient_AuthorizationURL(t *testing.T) {
+	t.Parallel()
+	c := NewClient(testClientID, testClientSecret, testRedirectURI, WithScopes(ScopeOpenID, ScopeCPID))
+	got, err := url.Parse(c.AuthorizationURL("state"))
+	require.Nil(t, err)
+	assert.Equal(t, "auth.riotgames.com", got.Host)
+	assert.Equal(t, endpointAuthorize, got.Path)
+	assert.Equal(
+		t, url.Values{
+			"client_id":     {testClientID},
+			"redirect_uri":  {testRedirectURI},
+			"response_type": {"code"},
+			"scope":         {"openid cpid"},
+			"state":         {"state"},
+		}, got.Query(),
+	)
+}
+
+func TestClient_Exchange(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		code    string
+		secret  string
+		wantErr error
+	}{
+		{
+			name:   "valid code",
+			code:   "code",
+			secret: testClientSecret,
+		},
+		{
+			name:    "invalid code",
+			code:    "other",
+			secret:  testClientSecret,
+			wantErr: &Error{StatusCode: http.StatusBadRequest, Code: "invalid_grant", Description: "unknown grant"},
+		},
+		{
+			name:    "invalid client",
+			code:    "code",
+			secret:  "wrong",
+			wantErr: &Error{StatusCode: http.StatusUnauthorized, Code: "invalid_client"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				server := newTestServer(t, nil)
+				c := newTestClient(server)
+				c.ClientSecret = tt.secret
+				got, err := c.Exchange(tt.code)
+				require.Equal(t, tt.wantErr, err)
+				if tt.wantErr != nil {
+					return
+				}
+				assert.Equal(t, "access", got.AccessToken)
+				assert.Equal(t, "refresh", got.RefreshToken)
+				assert.True(t, got.Valid())
+				assert.Equal(t, "Bearer access", got.Authorization())
+				claims, err := DecodeIDToken(got.IDToken)
+				require.Nil(t, err)
+				assert.Equal(t, "puuid", claims.Subject)
+				assert.True(t, claims.Audience.Contains(testClientID))
+				assert.False(t, claims.Expired(time.Unix(1500000000, 0)))
+			},
+		)
+	}
+}
+
+func TestClient_Refresh(t *testing.T) {
+	t.Parallel()
+	server := newTestServer(t, nil)
+	got, err := newTestClient(server).Refresh("refresh")
+	require.Nil(t, err)
+	assert.Equal(t, "refreshed", got.AccessToken)
+	assert.Equal(t, "refresh", got.RefreshToken, "refresh token should be kept if none is returned")
+	_, err = newTestClient(server).Refresh("invalid")
+	assert.NotNil(t, err)
+}
+
+func TestClient_TokenSource(t *testing.T) {
+	t.Parallel()
+	var refreshCount int32
+	server := newTestServer(t, &refreshCount)
+	c := newTestClient(server)
+	now := time.Now()
+	c.now = func() time.Time {
+		return now
+	}
+	ts := c.TokenSource(&Token{AccessToken: "access", RefreshToken: "refresh", Expiry: now.Add(time.Hour)})
+	authorization, err := Authorization(ts)
+	require.Nil(t, err)
+	assert.Equal(t, "Bearer access", authorization)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&refreshCount))
+
+	now = now.Add(2 * time.Hour)
+	authorization, err = Authorization(ts)
+	require.Nil(t, err)
+	assert.Equal(t, "Bearer refreshed", authorization)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&refreshCount))
+
+	_, err = c.TokenSource(&Token{AccessToken: "access", Expiry: now.Add(-time.Hour)}).Token()
+	assert.Equal(t, ErrNoRefreshToken, err)
+}
+
+func TestDecodeIDToken(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{
+			name:  "valid",
+			token: testIDToken(t),
+		},
+		{
+			name:    "missing parts",
+			token:   "header.payload",
+			wantErr: ErrMalformedIDToken,
+		},
+		{
+			name:    "invalid payload",
+			token:   "header.!!!.signature",
+			wantErr: ErrMalformedIDToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := DecodeIDToken(tt.token)
+				require.Equal(t, tt.wantErr, err)
+				if tt.wantErr == nil {
+					assert.Equal(t, "puuid", got.Subject)
+					assert.Equal(t, "puuid", got.Raw["sub"])
+				}
+			},
+		)
+	}
+}
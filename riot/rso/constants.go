@@ -0,0 +1,19 @@
+package rso
+
+const (
+	defaultBaseURL         = "https://auth.riotgames.com"
+	endpointAuthorize      = "/authorize"
+	endpointToken          = "/token"
+	grantAuthorizationCode = "authorization_code"
+	grantRefreshToken      = "refresh_token"
+)
+
+// Scope is an OAuth2 scope that can be requested during authorization
+type Scope string
+
+// All scopes supported by Riot Sign-On
+const (
+	ScopeOpenID        Scope = "openid"
+	ScopeOfflineAccess Scope = "offline_access"
+	ScopeCPID          Scope = "cpid"
+)
@@ -0,0 +1,33 @@
+package rso
+
+import (
+	"errors"
+	"fmt"
+)
+
+// All errors returned by this package besides Error
+var (
+	// ErrMalformedIDToken is returned if an ID token can not be decoded
+	ErrMalformedIDToken = errors.New("rso: malformed id token")
+	// ErrNoRefreshToken is returned if an expired token can not be refreshed because it has no refresh token
+	ErrNoRefreshToken = errors.New("rso: token expired and has no refresh token")
+)
+
+// Error is returned if the authorization server rejects a request
+type Error struct {
+	StatusCode int
+	// OAuth2 error code, e.g. invalid_grant
+	Code string `json:"error"`
+	// Human readable description of the error
+	Description string `json:"error_description"`
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("rso: request failed with status %d", e.StatusCode)
+	}
+	if e.Description == "" {
+		return fmt.Sprintf("rso: %s", e.Code)
+	}
+	return fmt.Sprintf("rso: %s: %s", e.Code, e.Description)
+}
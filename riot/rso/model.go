@@ -0,0 +1,109 @@
+package rso
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// expiryDelta is subtracted from the expiry of a token to account for clock skew and request latency
+const expiryDelta = 10 * time.Second
+
+// Token is an OAuth2 token issued by Riot Sign-On
+type Token struct {
+	// Token to use for requests on behalf of the player
+	AccessToken string `json:"access_token"`
+	// Token used to request a new access token once the current one expired
+	RefreshToken string `json:"refresh_token"`
+	// JWT containing the claims about the authenticated player, see DecodeIDToken
+	IDToken string `json:"id_token"`
+	// Type of the access token, usually "Bearer"
+	TokenType string `json:"token_type"`
+	// Space separated list of scopes granted to the token
+	Scope string `json:"scope"`
+	// Lifetime of the access token in seconds
+	ExpiresIn int `json:"expires_in"`
+	// Point in time when the access token expires. Calculated from ExpiresIn when the token is issued
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the token has an access token which has not expired yet
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && !t.expired(time.Now())
+}
+
+// Authorization returns the value for the Authorization header of requests authenticated with this token.
+// It can be passed as the authorization to any method of golio which takes one, e.g.
+// tft.SummonerClient.GetSummonerByMe or lor.DeckClient.List.
+func (t *Token) Authorization() string {
+	tokenType := t.TokenType
+	if tokenType == "" {
+		tokenType = "Bearer"
+	}
+	return tokenType + " " + t.AccessToken
+}
+
+func (t *Token) expired(now time.Time) bool {
+	if t.Expiry.IsZero() {
+		return false
+	}
+	return t.Expiry.Add(-expiryDelta).Before(now)
+}
+
+// IDTokenClaims contains the claims of an ID token issued by Riot Sign-On
+type IDTokenClaims struct {
+	// PUUID of the authenticated player
+	Subject string `json:"sub"`
+	// Issuer of the token
+	Issuer string `json:"iss"`
+	// Client IDs the token was issued for
+	Audience Audience `json:"aud"`
+	// Unix timestamp when the token expires
+	ExpiresAt int64 `json:"exp"`
+	// Unix timestamp when the token was issued
+	IssuedAt int64 `json:"iat"`
+	// Unix timestamp when the player authenticated
+	AuthTime int64 `json:"auth_time"`
+	// Nonce passed in the authorization request
+	Nonce string `json:"nonce"`
+	// Authentication context class reference
+	ACR string `json:"acr"`
+	// Authentication methods used
+	AMR []string `json:"amr"`
+	// Player's region, only present when the cpid scope was requested
+	CPID string `json:"cpid"`
+	// All claims contained in the token, including the ones above
+	Raw map[string]interface{} `json:"-"`
+}
+
+// Expired reports whether the ID token has expired at the given point in time
+func (c *IDTokenClaims) Expired(now time.Time) bool {
+	return c.ExpiresAt != 0 && time.Unix(c.ExpiresAt, 0).Before(now)
+}
+
+// Audience is the audience claim of a token. It is sent either as a single string or as a list of strings
+type Audience []string
+
+// UnmarshalJSON unmarshals a JSON string or list of strings into an Audience
+func (a *Audience) UnmarshalJSON(in []byte) error {
+	var single string
+	if err := json.Unmarshal(in, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(in, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+// Contains reports whether the given client ID is part of the audience
+func (a Audience) Contains(clientID string) bool {
+	for _, aud := range a {
+		if aud == clientID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package rso
+
+import (
+	"sync"
+)
+
+// TokenSource provides a valid token for requests on behalf of a player
+type TokenSource interface {
+	// Token returns a valid token or an error if no valid token can be provided
+	Token() (*Token, error)
+}
+
+// Authorization returns the value for the Authorization header of the current token of the token source,
+// see Token.Authorization
+func Authorization(ts TokenSource) (string, error) {
+	token, err := ts.Token()
+	if err != nil {
+		return "", err
+	}
+	return token.Authorization(), nil
+}
+
+// StaticTokenSource returns a TokenSource which always returns the given token
+func StaticTokenSource(token *Token) TokenSource {
+	return staticTokenSource{token: token}
+}
+
+type staticTokenSource struct {
+	token *Token
+}
+
+func (s staticTokenSource) Token() (*Token, error) {
+	return s.token, nil
+}
+
+type refreshingTokenSource struct {
+	mu     sync.Mutex
+	client *Client
+	token  *Token
+}
+
+func (s *refreshingTokenSource) Token() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != nil && s.token.AccessToken != "" && !s.token.expired(s.client.now()) {
+		return s.token, nil
+	}
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, ErrNoRefreshToken
+	}
+	token, err := s.client.Refresh(s.token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	return token, nil
+}
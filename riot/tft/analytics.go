@@ -0,0 +1,171 @@
+package tft
+
+import (
+	"sort"
+	"strings"
+)
+
+// PlacementStats aggregates the placements of a group of participants
+type PlacementStats struct {
+	// Number of participants
+	Count int
+	// Number of participants who placed first
+	Wins int
+	// Number of participants who placed in the top four
+	Top4 int
+	// Sum of the placements of all participants
+	TotalPlacement int64
+}
+
+// AveragePlacement returns the average placement or 0 if there are no participants
+func (s *PlacementStats) AveragePlacement() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.TotalPlacement) / float64(s.Count)
+}
+
+// WinRate returns the share of participants who placed first
+func (s *PlacementStats) WinRate() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.Wins) / float64(s.Count)
+}
+
+// Top4Rate returns the share of participants who placed in the top four
+func (s *PlacementStats) Top4Rate() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.Top4) / float64(s.Count)
+}
+
+func (s *PlacementStats) add(placement int64) {
+	s.Count++
+	s.TotalPlacement += placement
+	if placement == 1 {
+		s.Wins++
+	}
+	if placement <= 4 {
+		s.Top4++
+	}
+}
+
+// ItemStats contains how often items were built on a champion
+type ItemStats struct {
+	// Number of units of the champion across all participants
+	Units int
+	// Number of times each item was built on the champion, by item name
+	Items map[string]int
+}
+
+// Frequency returns the share of units of the champion which had the item
+func (s *ItemStats) Frequency(item string) float64 {
+	if s.Units == 0 {
+		return 0
+	}
+	return float64(s.Items[item]) / float64(s.Units)
+}
+
+// PlacementByTrait returns the placement stats of the participants with an active trait, by trait name
+func PlacementByTrait(matches []*Match) map[string]*PlacementStats {
+	stats := map[string]*PlacementStats{}
+	forEachParticipant(
+		matches, func(participant *Participant) {
+			for _, trait := range participant.Traits {
+				if trait.TierCurrent > 0 {
+					placementStats(stats, trait.Name).add(participant.Placement)
+				}
+			}
+		},
+	)
+	return stats
+}
+
+// PlacementByComposition returns the placement stats of the participants by unit composition. A composition is
+// identified by the sorted character IDs of its units joined by commas, see CompositionKey.
+func PlacementByComposition(matches []*Match) map[string]*PlacementStats {
+	stats := map[string]*PlacementStats{}
+	forEachParticipant(
+		matches, func(participant *Participant) {
+			placementStats(stats, CompositionKey(participant.Units)).add(participant.Placement)
+		},
+	)
+	return stats
+}
+
+// CompositionKey returns the key identifying the composition of the units in PlacementByComposition
+func CompositionKey(units []Unit) string {
+	ids := make([]string, len(units))
+	for i, unit := range units {
+		ids[i] = unit.CharacterID
+	}
+	sort.Strings(ids)
+	return strings.Join(ids, ",")
+}
+
+// ItemFrequency returns the items built on each champion, by character ID
+func ItemFrequency(matches []*Match) map[string]*ItemStats {
+	stats := map[string]*ItemStats{}
+	forEachParticipant(
+		matches, func(participant *Participant) {
+			for _, unit := range participant.Units {
+				s, ok := stats[unit.CharacterID]
+				if !ok {
+					s = &ItemStats{Items: map[string]int{}}
+					stats[unit.CharacterID] = s
+				}
+				s.Units++
+				for _, item := range unit.ItemNames {
+					s.Items[item]++
+				}
+			}
+		},
+	)
+	return stats
+}
+
+// PlacementByAugment returns the placement stats of the participants who picked an augment, by augment name
+func PlacementByAugment(matches []*Match) map[string]*PlacementStats {
+	stats := map[string]*PlacementStats{}
+	forEachParticipant(
+		matches, func(participant *Participant) {
+			for _, augment := range participant.Augments {
+				placementStats(stats, augment).add(participant.Placement)
+			}
+		},
+	)
+	return stats
+}
+
+// PlacementByCompanion returns the placement stats of the participants by the species of their companion
+func PlacementByCompanion(matches []*Match) map[string]*PlacementStats {
+	stats := map[string]*PlacementStats{}
+	forEachParticipant(
+		matches, func(participant *Participant) {
+			placementStats(stats, participant.Companion.Species).add(participant.Placement)
+		},
+	)
+	return stats
+}
+
+func forEachParticipant(matches []*Match, f func(participant *Participant)) {
+	for _, match := range matches {
+		if match == nil {
+			continue
+		}
+		for i := range match.Info.Participants {
+			f(&match.Info.Participants[i])
+		}
+	}
+}
+
+func placementStats(stats map[string]*PlacementStats, key string) *PlacementStats {
+	s, ok := stats[key]
+	if !ok {
+		s = &PlacementStats{}
+		stats[key] = s
+	}
+	return s
+}
@@ -0,0 +1,134 @@
+package tft
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMatches() []*Match {
+	return []*Match{
+		{
+			Info: MatchInfo{
+				Participants: []Participant{
+					{
+						Placement: 1,
+						Augments:  []string{"TFT_Augment_A", "TFT_Augment_B"},
+						Companion: Companion{Species: "PetChibi"},
+						Traits: []Trait{
+							{Name: "Set9_Bruiser", TierCurrent: 2},
+							{Name: "Set9_Ionia", TierCurrent: 0},
+						},
+						Units: []Unit{
+							{CharacterID: "TFT9_Sett", ItemNames: []string{"TFT_Item_Warmogs", "TFT_Item_Bramble"}},
+							{CharacterID: "TFT9_Ahri", ItemNames: []string{"TFT_Item_Shojin"}},
+						},
+					},
+					{
+						Placement: 5,
+						Augments:  []string{"TFT_Augment_A"},
+						Companion: Companion{Species: "PetGhosty"},
+						Traits:    []Trait{{Name: "Set9_Bruiser", TierCurrent: 1}},
+						Units: []Unit{
+							{CharacterID: "TFT9_Ahri"},
+							{CharacterID: "TFT9_Sett", ItemNames: []string{"TFT_Item_Warmogs"}},
+						},
+					},
+				},
+			},
+		},
+		nil,
+		{
+			Info: MatchInfo{
+				Participants: []Participant{
+					{
+						Placement: 3,
+						Augments:  []string{"TFT_Augment_B"},
+						Companion: Companion{Species: "PetChibi"},
+						Traits:    []Trait{{Name: "Set9_Ionia", TierCurrent: 1}},
+						Units:     []Unit{{CharacterID: "TFT9_Sett"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPlacementStats(t *testing.T) {
+	t.Parallel()
+	stats := &PlacementStats{}
+	assert.Equal(t, 0.0, stats.AveragePlacement())
+	assert.Equal(t, 0.0, stats.WinRate())
+	assert.Equal(t, 0.0, stats.Top4Rate())
+	for _, placement := range []int64{1, 4, 5, 8} {
+		stats.add(placement)
+	}
+	assert.Equal(t, &PlacementStats{Count: 4, Wins: 1, Top4: 2, TotalPlacement: 18}, stats)
+	assert.Equal(t, 4.5, stats.AveragePlacement())
+	assert.Equal(t, 0.25, stats.WinRate())
+	assert.Equal(t, 0.5, stats.Top4Rate())
+}
+
+func TestPlacementByTrait(t *testing.T) {
+	t.Parallel()
+	got := PlacementByTrait(testMatches())
+	assert.Equal(
+		t, map[string]*PlacementStats{
+			"Set9_Bruiser": {Count: 2, Wins: 1, Top4: 1, TotalPlacement: 6},
+			"Set9_Ionia":   {Count: 1, Top4: 1, TotalPlacement: 3},
+		}, got,
+	)
+	assert.Equal(t, 3.0, got["Set9_Bruiser"].AveragePlacement())
+}
+
+func TestPlacementByComposition(t *testing.T) {
+	t.Parallel()
+	assert.Equal(
+		t, map[string]*PlacementStats{
+			"TFT9_Ahri,TFT9_Sett": {Count: 2, Wins: 1, Top4: 1, TotalPlacement: 6},
+			"TFT9_Sett":           {Count: 1, Top4: 1, TotalPlacement: 3},
+		}, PlacementByComposition(testMatches()),
+	)
+}
+
+func TestItemFrequency(t *testing.T) {
+	t.Parallel()
+	got := ItemFrequency(testMatches())
+	assert.Equal(
+		t, map[string]*ItemStats{
+			"TFT9_Sett": {Units: 3, Items: map[string]int{"TFT_Item_Warmogs": 2, "TFT_Item_Bramble": 1}},
+			"TFT9_Ahri": {Units: 2, Items: map[string]int{"TFT_Item_Shojin": 1}},
+		}, got,
+	)
+	assert.InDelta(t, 2.0/3, got["TFT9_Sett"].Frequency("TFT_Item_Warmogs"), 1e-9)
+	assert.Equal(t, 0.0, got["TFT9_Ahri"].Frequency("TFT_Item_Warmogs"))
+}
+
+func TestPlacementByAugment(t *testing.T) {
+	t.Parallel()
+	assert.Equal(
+		t, map[string]*PlacementStats{
+			"TFT_Augment_A": {Count: 2, Wins: 1, Top4: 1, TotalPlacement: 6},
+			"TFT_Augment_B": {Count: 2, Wins: 1, Top4: 2, TotalPlacement: 4},
+		}, PlacementByAugment(testMatches()),
+	)
+}
+
+func TestPlacementByCompanion(t *testing.T) {
+	t.Parallel()
+	assert.Equal(
+		t, map[string]*PlacementStats{
+			"PetChibi":  {Count: 2, Wins: 1, Top4: 2, TotalPlacement: 4},
+			"PetGhosty": {Count: 1, TotalPlacement: 5},
+		}, PlacementByCompanion(testMatches()),
+	)
+}
+
+func TestUnit_ItemNames(t *testing.T) {
+	t.Parallel()
+	var unit Unit
+	require.Nil(t, json.Unmarshal([]byte(`{"character_id":"TFT9_Sett","itemNames":["TFT_Item_Warmogs"]}`), &unit))
+	assert.Equal(t, []string{"TFT_Item_Warmogs"}, unit.ItemNames)
+}
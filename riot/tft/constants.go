@@ -8,9 +8,10 @@ const (
 	endpointSpectatorFeaturedGames      = endpointSpectatorBase + "/v5/featured-games"
 
 	endpointLeagueBase                = endpointBase + "/league/v1"
+	endpointLeagueByPUUID             = endpointLeagueBase + "/by-puuid/%s"
 	endpointLeagueChallenger          = endpointLeagueBase + "/challenger?queue=%s"
 	endpointLeagueEntriesBySummoner   = endpointLeagueBase + "/entries/by-summoner/%s"
-	endpointLeagueEntries             = endpointLeagueBase + "/entries/%s/%s"
+	endpointLeagueEntries             = endpointLeagueBase + "/entries/%s/%s?queue=%s&page=%d"
 	endpointLeagueGrandMaster         = endpointLeagueBase + "/grandmaster?queue=%s"
 	endpointLeagueLeagues             = endpointLeagueBase + "/leagues/%s"
 	endpointLeagueMaster              = endpointLeagueBase + "/master?queue=%s"
@@ -35,9 +36,31 @@ type queue string
 const (
 	QueueRankedTFT         queue = "RANKED_TFT"
 	QueueRankedTFTDoubleUp queue = "RANKED_TFT_DOUBLE_UP"
-	QueueRankedTFTTurbo    queue = "RANKED_TFT_TURBO"
+	// The Hyper Roll queue, which uses rated tiers instead of leagues
+	QueueRankedTFTTurbo queue = "RANKED_TFT_TURBO"
 )
 
+// RatedTier is the tier of a player in the Hyper Roll queue, which is rated instead of using leagues
+type RatedTier string
+
+// All possible rated tiers, from lowest to highest
+const (
+	RatedTierGray   RatedTier = "GRAY"
+	RatedTierGreen  RatedTier = "GREEN"
+	RatedTierBlue   RatedTier = "BLUE"
+	RatedTierPurple RatedTier = "PURPLE"
+	RatedTierOrange RatedTier = "ORANGE"
+)
+
+// RatedTiers contains all rated tiers, from lowest to highest
+var RatedTiers = []RatedTier{
+	RatedTierGray,
+	RatedTierGreen,
+	RatedTierBlue,
+	RatedTierPurple,
+	RatedTierOrange,
+}
+
 type tier string
 
 // All possible Tiers
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/pagination"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -27,6 +28,18 @@ func (lc *LeagueClient) GetChallenger(queue queue) (*LeagueList, error) {
 	return out, nil
 }
 
+// GetEntriesByPUUID returns the league entries of all queues for a given PUUID
+func (lc *LeagueClient) GetEntriesByPUUID(puuid string) ([]*LeagueEntry, error) {
+	logger := lc.logger().WithField("method", "GetEntriesByPUUID")
+	url := fmt.Sprintf(endpointLeagueByPUUID, puuid)
+	var out []*LeagueEntry
+	if err := lc.c.GetInto(url, &out); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return out, nil
+}
+
 // GetEntriesBySummoner returns league entries for a given summoner ID
 func (lc *LeagueClient) GetEntriesBySummoner(summonerID string) ([]*LeagueEntry, error) {
 	logger := lc.logger().WithField("method", "GetEntriesBySummoner")
@@ -39,10 +52,19 @@ func (lc *LeagueClient) GetEntriesBySummoner(summonerID string) ([]*LeagueEntry,
 	return out, nil
 }
 
-// GetEntries returns all the league entries
+// GetEntries returns the first page of the league entries of the RANKED_TFT queue in a tier and division
 func (lc *LeagueClient) GetEntries(tier tier, division division) ([]*LeagueEntry, error) {
-	logger := lc.logger().WithField("method", "GetEntries")
-	url := fmt.Sprintf(endpointLeagueEntries, tier, division)
+	return lc.GetEntriesPage(QueueRankedTFT, tier, division, 1)
+}
+
+// GetEntriesPage returns a single page of the league entries of a queue in a tier and division. Pages start at 1.
+// The queue defaults to RANKED_TFT if empty.
+func (lc *LeagueClient) GetEntriesPage(queue queue, tier tier, division division, page int) ([]*LeagueEntry, error) {
+	logger := lc.logger().WithField("method", "GetEntriesPage")
+	if queue == "" {
+		queue = QueueRankedTFT
+	}
+	url := fmt.Sprintf(endpointLeagueEntries, tier, division, queue, page)
 	var out []*LeagueEntry
 	if err := lc.c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
@@ -51,6 +73,18 @@ func (lc *LeagueClient) GetEntries(tier tier, division division) ([]*LeagueEntry
 	return out, nil
 }
 
+// IterateEntries returns an iterator over all pages of the league entries of a queue in a tier and division.
+// The page size is defined by the API, so the PageSize option is ignored.
+func (lc *LeagueClient) IterateEntries(
+	queue queue, tier tier, division division, options *pagination.Options,
+) *pagination.Iterator[*LeagueEntry] {
+	return pagination.New(
+		func(page pagination.Page) ([]*LeagueEntry, error) {
+			return lc.GetEntriesPage(queue, tier, division, page.Index+1)
+		}, 0, options,
+	)
+}
+
 // GetGrandMaster returns the current GrandMaster league for the Region
 func (lc *LeagueClient) GetGrandMaster(queue queue) (*LeagueList, error) {
 	logger := lc.logger().WithField("method", "GetGrandMaster")
@@ -93,9 +127,13 @@ func (lc *LeagueClient) GetMaster(queue queue) (*LeagueList, error) {
 	return out, nil
 }
 
-// GetRatedLaddersByQueue returns the top rated ladder for given queue
+// GetRatedLaddersByQueue returns the top rated ladder for given queue. Only the Hyper Roll queue RANKED_TFT_TURBO
+// is rated, which is used if the queue is empty.
 func (lc *LeagueClient) GetRatedLaddersByQueue(queue queue) ([]*TopRatedLadderEntry, error) {
 	logger := lc.logger().WithField("method", "GetRatedLaddersByQueue")
+	if queue == "" {
+		queue = QueueRankedTFTTurbo
+	}
 	url := fmt.Sprintf(endpointLeagueRatedLattersByQueue, queue)
 	var out []*TopRatedLadderEntry
 	if err := lc.c.GetInto(url, &out); err != nil {
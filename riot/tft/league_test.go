@@ -5,10 +5,12 @@ import (
     "github.com/KnutZuidema/golio/api"
     "github.com/KnutZuidema/golio/internal"
     "github.com/KnutZuidema/golio/internal/mock"
+    "github.com/KnutZuidema/golio/pagination"
     "github.com/sirupsen/logrus"
     "github.com/stretchr/testify/assert"
     "github.com/stretchr/testify/require"
     "net/http"
+    "strconv"
     "testing"
 )
 
@@ -242,3 +244,102 @@ func TestTFTLeague_GetRatedLaddersByQueue(t *testing.T) {
         )
     }
 }
+
+func TestTFTLeague_GetEntriesByPUUID(t *testing.T) {
+    t.Parallel()
+    tests := []struct {
+        name    string
+        want    []*LeagueEntry
+        doer    internal.Doer
+        wantErr error
+    }{
+        {
+            name: "get response",
+            want: []*LeagueEntry{{PUUID: "puuid", QueueType: "RANKED_TFT_TURBO", RatedTier: RatedTierPurple}},
+            doer: mock.NewJSONMockDoer(
+                []*LeagueEntry{{PUUID: "puuid", QueueType: "RANKED_TFT_TURBO", RatedTier: RatedTierPurple}}, 200,
+            ),
+        },
+        {
+            name:    "not found",
+            wantErr: api.ErrNotFound,
+            doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+        },
+    }
+    for _, tt := range tests {
+        t.Run(
+            tt.name, func(t *testing.T) {
+                client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+                got, err := (&LeagueClient{c: client}).GetEntriesByPUUID("puuid")
+                require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+                if tt.wantErr == nil {
+                    assert.Equal(t, got, tt.want)
+                }
+            },
+        )
+    }
+}
+
+func TestTFTLeague_GetEntriesPage(t *testing.T) {
+    t.Parallel()
+    var url string
+    doer := &mock.Doer{
+        Custom: func(r *http.Request) (*http.Response, error) {
+            url = r.URL.Path + "?" + r.URL.RawQuery
+            return mock.NewJSONMockDoer([]*LeagueEntry{}, 200).Do(r)
+        },
+    }
+    client := &LeagueClient{c: internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())}
+    _, err := client.GetEntriesPage(QueueRankedTFTDoubleUp, TierGold, DivisionTwo, 3)
+    require.Nil(t, err)
+    assert.Equal(t, "/tft/league/v1/entries/GOLD/II?queue=RANKED_TFT_DOUBLE_UP&page=3", url)
+    _, err = client.GetEntries(TierGold, DivisionTwo)
+    require.Nil(t, err)
+    assert.Equal(t, "/tft/league/v1/entries/GOLD/II?queue=RANKED_TFT&page=1", url)
+}
+
+func TestTFTLeague_IterateEntries(t *testing.T) {
+    t.Parallel()
+    var pages []string
+    doer := &mock.Doer{
+        Custom: func(r *http.Request) (*http.Response, error) {
+            page := r.URL.Query().Get("page")
+            pages = append(pages, page)
+            entries := []*LeagueEntry{}
+            if n, _ := strconv.Atoi(page); n <= 2 {
+                entries = append(entries, &LeagueEntry{PUUID: page + "a"}, &LeagueEntry{PUUID: page + "b"})
+            }
+            return mock.NewJSONMockDoer(entries, 200).Do(r)
+        },
+    }
+    client := &LeagueClient{c: internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())}
+    got, err := client.IterateEntries(QueueRankedTFT, TierGold, DivisionOne, nil).Collect()
+    require.Nil(t, err)
+    require.Len(t, got, 4)
+    assert.Equal(t, "2b", got[3].PUUID)
+    assert.Equal(t, []string{"1", "2", "3"}, pages)
+
+    pages = nil
+    got, err = client.IterateEntries(QueueRankedTFT, TierGold, DivisionOne, &pagination.Options{MaxItems: 3}).Collect()
+    require.Nil(t, err)
+    assert.Len(t, got, 3)
+    assert.Equal(t, []string{"1", "2"}, pages)
+}
+
+func TestTFTLeague_GetRatedLaddersByQueueDefault(t *testing.T) {
+    t.Parallel()
+    var path string
+    doer := &mock.Doer{
+        Custom: func(r *http.Request) (*http.Response, error) {
+            path = r.URL.Path
+            return mock.NewJSONMockDoer(
+                []*TopRatedLadderEntry{{PUUID: "puuid", RatedTier: RatedTierOrange, RatedRating: 4000}}, 200,
+            ).Do(r)
+        },
+    }
+    client := &LeagueClient{c: internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())}
+    got, err := client.GetRatedLaddersByQueue("")
+    require.Nil(t, err)
+    assert.Equal(t, "/tft/league/v1/rated-ladders/RANKED_TFT_TURBO/top", path)
+    assert.Equal(t, []*TopRatedLadderEntry{{PUUID: "puuid", RatedTier: RatedTierOrange, RatedRating: 4000}}, got)
+}
@@ -1,43 +1,96 @@
 package tft
 
 import (
+	"errors"
 	"fmt"
+
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/pagination"
+	"github.com/KnutZuidema/golio/riot/lol"
 	log "github.com/sirupsen/logrus"
 )
 
+const maxMatchIDs = 100
+
 // MatchClient provides methods for match endpoints of the League of Legends TFT API.
 type MatchClient struct {
 	c *internal.Client
 }
 
-// GetMatchesByPUUID returns a list of match ids by PUUID
-func (mc *MatchClient) GetMatchesByPUUID(puuid string) ([]string, error) {
+// ErrUnsupportedMatchFilter is returned if the MatchListOptions filter by queue or type, which the TFT match list
+// does not support
+var ErrUnsupportedMatchFilter = errors.New("queue and type filters are not supported by the TFT match list")
+
+// MatchListOptions providing additional options for GetMatchesByPUUID. They are validated like the options of the
+// League of Legends match list, but the Queue and Type filters are not supported.
+type MatchListOptions = lol.MatchListOptions
+
+// GetMatchesByPUUID returns a list of match ids by PUUID. The options are validated before the request is sent.
+func (mc *MatchClient) GetMatchesByPUUID(puuid string, options ...*MatchListOptions) ([]string, error) {
 	logger := mc.logger().WithField("method", "GetMatchesByPUUID")
-	mc.c.Region = api.Region(api.RegionToRoute[mc.c.Region])
+	opts := &MatchListOptions{}
+	if len(options) != 0 && options[0] != nil {
+		opts = options[0]
+	}
+	if err := validateMatchListOptions(opts); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	c := *mc.c // copy client so it can be used concurrently
+	c.Region = api.Region(api.RegionToRoute[c.Region])
 	url := fmt.Sprintf(endpointMatchesByPUUID, puuid)
+	if values := opts.Values(); len(values) != 0 {
+		url += "?" + values.Encode()
+	}
 	var out []string
-	if err := mc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
 	return out, nil
 }
 
+// IterateMatchesByPUUID returns an iterator over all match ids by PUUID, requesting up to 100 ids per page.
+// Start and Count of the options are replaced by the pages of the iterator.
+func (mc *MatchClient) IterateMatchesByPUUID(
+	puuid string, pageOptions *pagination.Options, options ...*MatchListOptions,
+) *pagination.Iterator[string] {
+	opts := MatchListOptions{}
+	if len(options) != 0 && options[0] != nil {
+		opts = *options[0]
+	}
+	return pagination.New(
+		func(page pagination.Page) ([]string, error) {
+			pageOpts := opts
+			pageOpts.Start = page.Start
+			pageOpts.Count = page.Count
+			return mc.GetMatchesByPUUID(puuid, &pageOpts)
+		}, maxMatchIDs, pageOptions,
+	)
+}
+
 // GetMatchByMatchID returns a match by matchID
 func (mc *MatchClient) GetMatchByMatchID(matchId string) (*Match, error) {
 	logger := mc.logger().WithField("method", "GetMatchByMatchID")
-	mc.c.Region = api.Region(api.RegionToRoute[mc.c.Region])
+	c := *mc.c // copy client so it can be used concurrently
+	c.Region = api.Region(api.RegionToRoute[c.Region])
 	url := fmt.Sprintf(endpointMatchByMatchID, matchId)
 	var out *Match
-	if err := mc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
 	return out, nil
 }
 
+func validateMatchListOptions(options *MatchListOptions) error {
+	if options.Queue != nil || options.Type != "" {
+		return ErrUnsupportedMatchFilter
+	}
+	return options.Validate()
+}
+
 func (mc *MatchClient) logger() log.FieldLogger {
 	return mc.c.Logger().WithField("category", "match")
 }
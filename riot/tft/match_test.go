@@ -5,11 +5,15 @@ import (
     "github.com/KnutZuidema/golio/api"
     "github.com/KnutZuidema/golio/internal"
     "github.com/KnutZuidema/golio/internal/mock"
+    "github.com/KnutZuidema/golio/pagination"
+    "github.com/KnutZuidema/golio/riot/lol"
     "github.com/sirupsen/logrus"
     "github.com/stretchr/testify/assert"
     "github.com/stretchr/testify/require"
     "net/http"
+    "strconv"
     "testing"
+    "time"
 )
 
 func TestTFTMatch_GetMatchesByPUUID(t *testing.T) {
@@ -77,3 +81,61 @@ func TestTFTMatch_GetMatchByMatchID(t *testing.T) {
         )
     }
 }
+
+func TestTFTMatch_IterateMatchesByPUUID(t *testing.T) {
+    t.Parallel()
+    var requests []string
+    doer := &mock.Doer{
+        Custom: func(r *http.Request) (*http.Response, error) {
+            requests = append(requests, r.URL.Host+r.URL.Path+"?"+r.URL.RawQuery)
+            start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+            count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+            ids := []string{}
+            for i := start; i < 30 && i < start+count; i++ {
+                ids = append(ids, strconv.Itoa(i))
+            }
+            return mock.NewJSONMockDoer(ids, 200).Do(r)
+        },
+    }
+    client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+    got, err := (&MatchClient{c: client}).IterateMatchesByPUUID("puuid", &pagination.Options{PageSize: 20}).Collect()
+    require.Nil(t, err)
+    assert.Len(t, got, 30)
+    assert.Equal(t, []string{
+        "europe.api.riotgames.com/tft/match/v1/matches/by-puuid/puuid/ids?count=20",
+        "europe.api.riotgames.com/tft/match/v1/matches/by-puuid/puuid/ids?count=20&start=20",
+    }, requests)
+    assert.Equal(t, api.RegionEuropeWest, client.Region, "client region should not be changed")
+}
+
+func TestTFTMatch_GetMatchesByPUUIDWithOptions(t *testing.T) {
+    t.Parallel()
+    var query string
+    doer := &mock.Doer{
+        Custom: func(r *http.Request) (*http.Response, error) {
+            query = r.URL.RawQuery
+            return mock.NewJSONMockDoer([]string{}, 200).Do(r)
+        },
+    }
+    client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+    start := time.Unix(1672531200, 0)
+    _, err := (&MatchClient{c: client}).GetMatchesByPUUID("puuid", &MatchListOptions{
+        Start:     10,
+        Count:     50,
+        StartTime: start,
+        EndTime:   start.Add(time.Hour),
+    })
+    require.Nil(t, err)
+    assert.Equal(t, "count=50&endTime=1672534800&start=10&startTime=1672531200", query)
+    assert.Equal(t, api.RegionEuropeWest, client.Region, "client region should not be changed")
+
+    _, err = (&MatchClient{c: client}).GetMatchesByPUUID("puuid", &MatchListOptions{Count: 101})
+    assert.Equal(t, lol.ErrInvalidMatchCount, err)
+    _, err = (&MatchClient{c: client}).GetMatchesByPUUID("puuid", &MatchListOptions{
+        StartTime: start,
+        EndTime:   start.Add(-time.Hour),
+    })
+    assert.Equal(t, lol.ErrInvalidTimeRange, err)
+    _, err = (&MatchClient{c: client}).GetMatchesByPUUID("puuid", &MatchListOptions{Type: lol.MatchTypeRanked})
+    assert.Equal(t, ErrUnsupportedMatchFilter, err)
+}
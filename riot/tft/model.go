@@ -1,5 +1,11 @@
 package tft
 
+import (
+	"strconv"
+
+	"github.com/KnutZuidema/golio/datadragon"
+)
+
 // CurrentGameInfo contains current game information
 type CurrentGameInfo struct {
 	// The ID of the game
@@ -164,8 +170,8 @@ type LeagueEntry struct {
 	// Player's encrypted summonerId
 	SummonerID string `json:"summonerId"`
 	QueueType  string `json:"queueType"`
-	// Only included for the RANKED_TFT_TURBO queueType. (Legal values: ORANGE, PURPLE, BLUE, GREEN, GRAY)
-	RatedTier string `json:"ratedTier"`
+	// Only included for the RANKED_TFT_TURBO queueType.
+	RatedTier RatedTier `json:"ratedTier"`
 	// Only included for the RANKED_TFT_TURBO queueType.
 	RatedRating int `json:"ratedRating"`
 	// Not included for the RANKED_TFT_TURBO queueType.
@@ -191,11 +197,12 @@ type LeagueEntry struct {
 }
 
 type TopRatedLadderEntry struct {
+	// Player Universal Unique Identifier. Exact length of 78 characters. (Encrypted)
+	PUUID string `json:"puuid"`
 	// Player's encrypted summonerId.
-	SummonerID string `json:"summonerId"`
-	// (Legal values: ORANGE, PURPLE, BLUE, GREEN, GRAY)
-	RatedTier   string `json:"ratedTier"`
-	RatedRating int    `json:"ratedRating"`
+	SummonerID  string    `json:"summonerId"`
+	RatedTier   RatedTier `json:"ratedTier"`
+	RatedRating int       `json:"ratedRating"`
 	// First placement
 	Wins                         int `json:"wins"`
 	PreviousUpdateLadderPosition int `json:"previousUpdateLadderPosition"`
@@ -222,9 +229,15 @@ type MatchInfo struct {
 	QueueID int64 `json:"queueId"`
 	// Teamfight Tactics set number
 	TFTSetNumber int64 `json:"tft_set_number"`
+	// Name of the set, e.g. TFTSet9_2 for the mid-set update of set 9
+	TFTSetCoreName string `json:"tft_set_core_name"`
+	// Game type, e.g. standard or pairs
+	TFTGameType string `json:"tft_game_type"`
 }
 
 type Participant struct {
+	// Names of the augments the participant picked
+	Augments []string `json:"augments"`
 	// Participant's companion
 	Companion Companion `json:"companion"`
 	// Gold left after participant was eliminated
@@ -235,9 +248,15 @@ type Participant struct {
 	Level int64 `json:"level"`
 	// Participant placement upon elimination
 	Placement int64 `json:"placement"`
+	// Progress of the participant's missions by mission name
+	Missions map[string]int64 `json:"missions"`
+	// ID of the participant's team in Double Up games. Excluded from the response in other games.
+	PartnerGroupID int64 `json:"partner_group_id"`
 	// Number of players the participant eliminated.
 	PlayersEliminated int64  `json:"players_eliminated"`
 	PUUID             string `json:"puuid"`
+	RiotIDGameName    string `json:"riotIdGameName"`
+	RiotIDTagline     string `json:"riotIdTagline"`
 	// The number of seconds before the participant was eliminated
 	TimeEliminated float64 `json:"time_eliminated"`
 	// Damage the participant dealt to other players.
@@ -248,6 +267,19 @@ type Participant struct {
 	Units []Unit `json:"units"`
 }
 
+// GetAugments returns the augments the participant picked in a match of the given set
+func (p *Participant) GetAugments(client *datadragon.TFTClient, set int64) ([]datadragon.TFTAugment, error) {
+	res := make([]datadragon.TFTAugment, 0, len(p.Augments))
+	for _, id := range p.Augments {
+		augment, err := client.GetAugment(int(set), id)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, augment)
+	}
+	return res, nil
+}
+
 type Companion struct {
 	ContentID string `json:"content_ID"`
 	ItemID    int64  `json:"item_ID"`
@@ -255,6 +287,11 @@ type Companion struct {
 	Species   string `json:"species"`
 }
 
+// GetTactician returns the tactician of the companion
+func (c *Companion) GetTactician(client *datadragon.TFTClient) (datadragon.TFTTactician, error) {
+	return client.GetTactician(strconv.FormatInt(c.ItemID, 10))
+}
+
 type Trait struct {
 	// Trait name
 	Name string `json:"name"`
@@ -268,11 +305,16 @@ type Trait struct {
 	TierTotal int64 `json:"tier_total"`
 }
 
+// GetTrait returns the trait in a match of the given set
+func (t *Trait) GetTrait(client *datadragon.TFTClient, set int64) (datadragon.TFTTrait, error) {
+	return client.GetTrait(int(set), t.Name)
+}
+
 type Unit struct {
 	// This field was introduced in patch 9.22 with data_version 2.
 	CharacterID string `json:"character_id"`
-	// A list of the unit's items. Please refer to the Teamfight Tactics documentation for item ids.
-	Items []int `json:"itemNames"`
+	// A list of the unit's item names. Please refer to the Teamfight Tactics documentation for item names.
+	ItemNames []string `json:"itemNames"`
 	// If a unit is chosen as part of the Fates set mechanic, the chosen trait will be indicated by this field. Otherwise this field is excluded from the response.
 	Chosen string `json:"chosen"`
 	Name   string `json:"name"`
@@ -282,6 +324,24 @@ type Unit struct {
 	Tier int `json:"tier"`
 }
 
+// GetChampion returns the champion of the unit in a match of the given set
+func (u *Unit) GetChampion(client *datadragon.TFTClient, set int64) (datadragon.TFTChampion, error) {
+	return client.GetChampion(int(set), u.CharacterID)
+}
+
+// GetItems returns the items of the unit in a match of the given set
+func (u *Unit) GetItems(client *datadragon.TFTClient, set int64) ([]datadragon.TFTItem, error) {
+	res := make([]datadragon.TFTItem, 0, len(u.ItemNames))
+	for _, id := range u.ItemNames {
+		item, err := client.GetItem(int(set), id)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
+
 type Metadata struct {
 	DataVersion  string   `json:"data_version"`
 	MatchID      string   `json:"match_id"`
@@ -0,0 +1,101 @@
+package tft
+
+import (
+	"encoding/json"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/datadragon"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+type dataDragonResponse struct {
+	Type    string
+	Format  string
+	Version string
+	Data    interface{}
+}
+
+func newTestTFTClient() *datadragon.TFTClient {
+	doer := mock.NewJSONMockDoer(
+		dataDragonResponse{
+			Data: map[string]datadragon.TFTData{
+				"Maps/Shipping/Map22/Sets/TFTSet9/TFT9_Sett":  {ID: "TFT9_Sett", Name: "Sett"},
+				"Maps/Shipping/Map22/Sets/TFTSet9/Set9_Ionia": {ID: "Set9_Ionia", Name: "Ionia"},
+				"TFT9_Augment_Tiny":                           {ID: "TFT9_Augment_Tiny", Name: "Tiny Titans"},
+				"TFT_Item_Warmogs":                            {ID: "TFT_Item_Warmogs", Name: "Warmog's Armor"},
+				"1":                                           {ID: "1", Name: "Silverwing"},
+			},
+		}, 200,
+	)
+	return datadragon.NewTFTClient(datadragon.NewClient(doer, api.RegionEuropeWest, log.StandardLogger()))
+}
+
+func TestUnit_GetChampion(t *testing.T) {
+	t.Parallel()
+	client := newTestTFTClient()
+	unit := &Unit{CharacterID: "TFT9_Sett", ItemNames: []string{"TFT_Item_Warmogs"}}
+	champion, err := unit.GetChampion(client, 9)
+	require.Nil(t, err)
+	assert.Equal(t, "Sett", champion.Name)
+	items, err := unit.GetItems(client, 9)
+	require.Nil(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Warmog's Armor", items[0].Name)
+	unit.ItemNames = append(unit.ItemNames, "TFT_Item_Unknown")
+	_, err = unit.GetItems(client, 9)
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestTrait_GetTrait(t *testing.T) {
+	t.Parallel()
+	trait, err := (&Trait{Name: "Set9_Ionia"}).GetTrait(newTestTFTClient(), 9)
+	require.Nil(t, err)
+	assert.Equal(t, "Ionia", trait.Name)
+}
+
+func TestParticipant_GetAugments(t *testing.T) {
+	t.Parallel()
+	augments, err := (&Participant{Augments: []string{"TFT9_Augment_Tiny"}}).GetAugments(newTestTFTClient(), 9)
+	require.Nil(t, err)
+	require.Len(t, augments, 1)
+	assert.Equal(t, "Tiny Titans", augments[0].Name)
+}
+
+func TestCompanion_GetTactician(t *testing.T) {
+	t.Parallel()
+	tactician, err := (&Companion{ItemID: 1}).GetTactician(newTestTFTClient())
+	require.Nil(t, err)
+	assert.Equal(t, "Silverwing", tactician.Name)
+}
+
+func TestParticipant_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+	var participant Participant
+	require.Nil(
+		t, json.Unmarshal(
+			[]byte(`{
+				"augments": ["TFT9_Augment_Tiny"],
+				"missions": {"PlayerScore2": 120},
+				"partner_group_id": 2,
+				"riotIdGameName": "name",
+				"riotIdTagline": "EUW",
+				"units": [{"character_id": "TFT9_Sett", "itemNames": ["TFT_Item_Warmogs"]}]
+			}`), &participant,
+		),
+	)
+	assert.Equal(
+		t, Participant{
+			Augments:       []string{"TFT9_Augment_Tiny"},
+			Missions:       map[string]int64{"PlayerScore2": 120},
+			PartnerGroupID: 2,
+			RiotIDGameName: "name",
+			RiotIDTagline:  "EUW",
+			Units:          []Unit{{CharacterID: "TFT9_Sett", ItemNames: []string{"TFT_Item_Warmogs"}}},
+		}, participant,
+	)
+}
@@ -0,0 +1,291 @@
+// Package analysis derives player and team statistics like ADR, KAST, first kills, clutches and plant
+// conversions from VALORANT matches.
+package analysis
+
+import (
+	"sort"
+
+	"github.com/KnutZuidema/golio/riot/val"
+)
+
+// TradeWindow is the time in milliseconds after a death in which the death counts as traded if a teammate kills the
+// killer
+const TradeWindow = 5000
+
+// PlayerStats contains the statistics of a player across all rounds of a match
+type PlayerStats struct {
+	PUUID  string
+	TeamID string
+	// Number of rounds the player played
+	Rounds  int
+	Kills   int
+	Deaths  int
+	Assists int
+	// Damage dealt to enemies
+	Damage    int
+	Headshots int
+	Bodyshots int
+	Legshots  int
+	// Number of rounds in which the player got the first kill
+	FirstKills int
+	// Number of rounds in which the player died first
+	FirstDeaths int
+	// Number of rounds in which the player got a kill or an assist, survived or was traded
+	KASTRounds int
+	// Number of rounds by the number of kills in the round, for rounds with at least two kills
+	MultiKills map[int]int
+	// Number of rounds in which the player was the last player alive of their team, by the number of enemies alive
+	ClutchAttempts map[int]int
+	// Number of rounds in which the player won as the last player alive of their team, by the number of enemies
+	// alive when the clutch started
+	Clutches map[int]int
+	Plants   int
+	Defuses  int
+	// Sum of the loadout values of all rounds
+	LoadoutValue int
+	// Credits spent across all rounds
+	Spent int
+}
+
+// ADR returns the average damage per round
+func (s *PlayerStats) ADR() float64 {
+	return ratio(s.Damage, s.Rounds)
+}
+
+// KAST returns the share of rounds in which the player got a kill or an assist, survived or was traded
+func (s *PlayerStats) KAST() float64 {
+	return ratio(s.KASTRounds, s.Rounds)
+}
+
+// HeadshotRate returns the share of headshots of all shots which hit an enemy
+func (s *PlayerStats) HeadshotRate() float64 {
+	return ratio(s.Headshots, s.Headshots+s.Bodyshots+s.Legshots)
+}
+
+// EconomyRating returns the damage dealt per 1000 credits spent
+func (s *PlayerStats) EconomyRating() float64 {
+	return ratio(s.Damage*1000, s.Spent)
+}
+
+// TeamStats contains the statistics of a team across all rounds of a match
+type TeamStats struct {
+	TeamID    string
+	Rounds    int
+	RoundsWon int
+	// Number of rounds in which the team planted the bomb
+	Plants int
+	// Number of rounds in which the team planted the bomb and won
+	PlantsWon int
+	// Number of rounds in which the enemy team planted the bomb
+	EnemyPlants int
+	// Number of rounds in which the team defused the bomb
+	Defuses int
+}
+
+// PlantConversion returns the share of rounds the team won after planting the bomb
+func (s *TeamStats) PlantConversion() float64 {
+	return ratio(s.PlantsWon, s.Plants)
+}
+
+// DefuseConversion returns the share of rounds in which the team defused the bomb after the enemy team planted it
+func (s *TeamStats) DefuseConversion() float64 {
+	return ratio(s.Defuses, s.EnemyPlants)
+}
+
+// Analysis contains the statistics of all players and teams of a match
+type Analysis struct {
+	// Statistics of the players by PUUID
+	Players map[string]*PlayerStats
+	// Statistics of the teams by team ID
+	Teams map[string]*TeamStats
+}
+
+// Analyze computes the statistics of the players and teams of the match. Kills, damage, plants and defuses of
+// PUUIDs which are not players of the match are skipped, e.g. the empty killer of a death by the spike. The death
+// of the victim is still counted.
+func Analyze(match *val.Match) *Analysis {
+	a := &Analysis{
+		Players: map[string]*PlayerStats{},
+		Teams:   map[string]*TeamStats{},
+	}
+	for _, player := range match.Players {
+		a.Players[player.PuuID] = newPlayerStats(player.PuuID, player.TeamID)
+		a.team(player.TeamID)
+	}
+	for _, team := range match.Teams {
+		a.team(team.TeamID)
+	}
+	for i := range match.RoundResults {
+		a.analyzeRound(&match.RoundResults[i])
+	}
+	return a
+}
+
+func (a *Analysis) analyzeRound(round *val.RoundResult) {
+	for _, team := range a.Teams {
+		team.Rounds++
+	}
+	if team, ok := a.Teams[round.WinningTeam]; ok {
+		team.RoundsWon++
+	}
+	alive := map[string]int{}
+	var kills []val.Kill
+	for _, stats := range round.PlayerStats {
+		player, ok := a.Players[stats.PUUID]
+		if !ok {
+			continue
+		}
+		player.Rounds++
+		alive[player.TeamID]++
+		player.LoadoutValue += stats.Economy.LoadOutValue
+		player.Spent += stats.Economy.Spent
+		for _, damage := range stats.Damages {
+			receiver, ok := a.Players[damage.Receiver]
+			if !ok || receiver.TeamID == player.TeamID {
+				continue
+			}
+			player.Damage += damage.Damage
+			player.Headshots += damage.Headshots
+			player.Bodyshots += damage.BodyShots
+			player.Legshots += damage.LegShots
+		}
+		kills = append(kills, stats.Kills...)
+	}
+	sort.SliceStable(
+		kills, func(i, j int) bool {
+			return kills[i].TimeSinceRoundStartMillis < kills[j].TimeSinceRoundStartMillis
+		},
+	)
+	a.analyzeKills(round, kills, alive)
+	if planter, ok := a.Players[round.BombPlanter]; ok {
+		planter.Plants++
+		for id, team := range a.Teams {
+			if id == planter.TeamID {
+				team.Plants++
+				if round.WinningTeam == id {
+					team.PlantsWon++
+				}
+			} else {
+				team.EnemyPlants++
+			}
+		}
+	}
+	if defuser, ok := a.Players[round.BombDefuser]; ok {
+		defuser.Defuses++
+		a.team(defuser.TeamID).Defuses++
+	}
+}
+
+// analyzeKills derives the kill based statistics of a round from its kills in chronological order and the number
+// of players of each team alive at the start of the round
+func (a *Analysis) analyzeKills(round *val.RoundResult, kills []val.Kill, alive map[string]int) {
+	roundKills := map[string]int{}
+	kast := map[string]bool{}
+	dead := map[string]bool{}
+	clutching := map[string]string{}
+	for i, kill := range kills {
+		victim, ok := a.Players[kill.Victim]
+		if !ok {
+			continue
+		}
+		killer, known := a.Players[kill.Killer]
+		dead[victim.PUUID] = true
+		victim.Deaths++
+		alive[victim.TeamID]--
+		if i == 0 {
+			victim.FirstDeaths++
+		}
+		if known && killer.TeamID != victim.TeamID {
+			killer.Kills++
+			roundKills[killer.PUUID]++
+			kast[killer.PUUID] = true
+			if i == 0 {
+				killer.FirstKills++
+			}
+		}
+		for _, puuid := range kill.Assistants {
+			if assistant, ok := a.Players[puuid]; ok {
+				assistant.Assists++
+				kast[puuid] = true
+			}
+		}
+		// the victim was traded if a teammate kills the killer within the trade window
+		for _, later := range kills[i+1:] {
+			if later.TimeSinceRoundStartMillis-kill.TimeSinceRoundStartMillis > TradeWindow {
+				break
+			}
+			if trader, ok := a.Players[later.Killer]; ok && known && later.Victim == kill.Killer &&
+				trader.TeamID == victim.TeamID {
+				kast[victim.PUUID] = true
+				break
+			}
+		}
+		if alive[victim.TeamID] == 1 && clutching[victim.TeamID] == "" {
+			a.startClutch(round, victim.TeamID, dead, alive, clutching)
+		}
+	}
+	for puuid, count := range roundKills {
+		if count >= 2 {
+			a.Players[puuid].MultiKills[count]++
+		}
+	}
+	for _, stats := range round.PlayerStats {
+		player, ok := a.Players[stats.PUUID]
+		if ok && (kast[stats.PUUID] || !dead[stats.PUUID]) {
+			player.KASTRounds++
+		}
+	}
+}
+
+// startClutch records a clutch attempt of the last player alive of the team and whether it was won
+func (a *Analysis) startClutch(
+	round *val.RoundResult, teamID string, dead map[string]bool, alive map[string]int, clutching map[string]string,
+) {
+	enemies := 0
+	for id, count := range alive {
+		if id != teamID {
+			enemies += count
+		}
+	}
+	if enemies == 0 {
+		return
+	}
+	for _, stats := range round.PlayerStats {
+		player, ok := a.Players[stats.PUUID]
+		if !ok || player.TeamID != teamID || dead[player.PUUID] {
+			continue
+		}
+		clutching[teamID] = player.PUUID
+		player.ClutchAttempts[enemies]++
+		if round.WinningTeam == teamID {
+			player.Clutches[enemies]++
+		}
+		return
+	}
+}
+
+func (a *Analysis) team(id string) *TeamStats {
+	team, ok := a.Teams[id]
+	if !ok {
+		team = &TeamStats{TeamID: id}
+		a.Teams[id] = team
+	}
+	return team
+}
+
+func newPlayerStats(puuid, teamID string) *PlayerStats {
+	return &PlayerStats{
+		PUUID:          puuid,
+		TeamID:         teamID,
+		MultiKills:     map[int]int{},
+		ClutchAttempts: map[int]int{},
+		Clutches:       map[int]int{},
+	}
+}
+
+func ratio(a, b int) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
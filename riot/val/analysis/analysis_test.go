@@ -0,0 +1,89 @@
+package analysis
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/riot/val"
+)
+
+// loadMatch loads testdata/match.json, a match of two players per team over four rounds:
+//   - round 1: a1 opens on b1, b2 trades a1, a2 wins the 1v1 against b2
+//   - round 2: b1 kills a1 and a2 and plants on A, the bomb detonates
+//   - round 3: b2 plants on B, a1 kills b2, b1 kills a2, a1 wins the 1v1 and defuses
+//   - round 4: b1 plants on A, a1 damages an unknown player, the bomb detonates and kills a2
+func loadMatch(t *testing.T) *val.Match {
+	data, err := os.ReadFile("testdata/match.json")
+	require.Nil(t, err)
+	var match val.Match
+	require.Nil(t, json.Unmarshal(data, &match))
+	return &match
+}
+
+func TestAnalyze_Players(t *testing.T) {
+	t.Parallel()
+	got := Analyze(loadMatch(t))
+	want := map[string]*PlayerStats{
+		"a1": {
+			PUUID: "a1", TeamID: "Blue", Rounds: 4, Kills: 3, Deaths: 2, Damage: 440, Headshots: 3, Bodyshots: 6,
+			FirstKills: 2, FirstDeaths: 1, KASTRounds: 3, MultiKills: map[int]int{2: 1},
+			ClutchAttempts: map[int]int{1: 1, 2: 1}, Clutches: map[int]int{1: 1}, Defuses: 1,
+			LoadoutValue: 7800, Spent: 7800,
+		},
+		"a2": {
+			PUUID: "a2", TeamID: "Blue", Rounds: 4, Kills: 1, Deaths: 3, Assists: 2, Damage: 160, Headshots: 1,
+			Bodyshots: 1, Legshots: 1, FirstDeaths: 1, KASTRounds: 2, MultiKills: map[int]int{},
+			ClutchAttempts: map[int]int{1: 1, 2: 1}, Clutches: map[int]int{1: 1},
+			LoadoutValue: 7800, Spent: 7800,
+		},
+		"b1": {
+			PUUID: "b1", TeamID: "Red", Rounds: 4, Kills: 3, Deaths: 2, Damage: 450, Headshots: 2, Bodyshots: 4,
+			Legshots: 1, FirstKills: 1, FirstDeaths: 1, KASTRounds: 4, MultiKills: map[int]int{2: 1},
+			ClutchAttempts: map[int]int{2: 1}, Clutches: map[int]int{}, Plants: 2,
+			LoadoutValue: 7800, Spent: 7800,
+		},
+		"b2": {
+			PUUID: "b2", TeamID: "Red", Rounds: 4, Kills: 1, Deaths: 2, Damage: 150, Bodyshots: 3, Legshots: 1,
+			FirstDeaths: 1, KASTRounds: 3, MultiKills: map[int]int{},
+			ClutchAttempts: map[int]int{2: 1}, Clutches: map[int]int{}, Plants: 1,
+			LoadoutValue: 7800, Spent: 7800,
+		},
+	}
+	assert.Equal(t, want, got.Players, "unknown killers and damage receivers should not be added as players")
+
+	a1 := got.Players["a1"]
+	assert.InDelta(t, 110, a1.ADR(), 0.01)
+	assert.InDelta(t, 0.75, a1.KAST(), 1e-9)
+	assert.InDelta(t, 1.0/3, a1.HeadshotRate(), 1e-9)
+	assert.InDelta(t, 56.41, a1.EconomyRating(), 0.01)
+}
+
+func TestAnalyze_Teams(t *testing.T) {
+	t.Parallel()
+	got := Analyze(loadMatch(t))
+	assert.Equal(
+		t, map[string]*TeamStats{
+			"Blue": {TeamID: "Blue", Rounds: 4, RoundsWon: 2, EnemyPlants: 3, Defuses: 1},
+			"Red":  {TeamID: "Red", Rounds: 4, RoundsWon: 2, Plants: 3, PlantsWon: 2},
+		}, got.Teams,
+	)
+	assert.InDelta(t, 2.0/3, got.Teams["Red"].PlantConversion(), 1e-9)
+	assert.InDelta(t, 1.0/3, got.Teams["Blue"].DefuseConversion(), 1e-9)
+	assert.Equal(t, 0.0, got.Teams["Blue"].PlantConversion())
+}
+
+func TestAnalyze_Empty(t *testing.T) {
+	t.Parallel()
+	got := Analyze(&val.Match{})
+	assert.Empty(t, got.Players)
+	assert.Empty(t, got.Teams)
+	stats := newPlayerStats("puuid", "Blue")
+	assert.Equal(t, 0.0, stats.ADR())
+	assert.Equal(t, 0.0, stats.KAST())
+	assert.Equal(t, 0.0, stats.HeadshotRate())
+	assert.Equal(t, 0.0, stats.EconomyRating())
+}
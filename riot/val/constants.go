@@ -5,7 +5,7 @@ import "github.com/KnutZuidema/golio/api"
 const (
 	endpointBase                  = "/val"
 	endpointContentBase           = endpointBase + "/content/v1"
-	endPointGetContent            = endpointContentBase + "/contents?%s"
+	endPointGetContent            = endpointContentBase + "/contents"
 	endpointStatusBase            = endpointBase + "/status/v1"
 	endpointGetPlatformData       = endpointStatusBase + "/platform-data"
 	endpointRankedBase            = endpointBase + "/ranked/v1"
@@ -14,6 +14,13 @@ const (
 	endpointMatchByID             = endpointMatchBase + "/matches/%s"
 	endpointMatchListByPUUID      = endpointMatchBase + "/matchlists/by-puuid/%s"
 	endpointRecentMatchesByQueue  = endpointMatchBase + "/recent-matches/by-queue/%s"
+
+	endpointConsoleRankedBase            = endpointBase + "/console/ranked/v1"
+	endpointGetConsoleLeaderboardByActID = endpointConsoleRankedBase + "/leaderboards/by-act/%s"
+	endpointConsoleMatchBase             = endpointBase + "/match/console/v1"
+	endpointConsoleMatchByID             = endpointConsoleMatchBase + "/matches/%s"
+	endpointConsoleMatchListByPUUID      = endpointConsoleMatchBase + "/matchlists/by-puuid/%s?platformType=%s"
+	endpointConsoleRecentMatchesByQueue  = endpointConsoleMatchBase + "/recent-matches/by-queue/%s"
 )
 
 // All existing regions
@@ -51,6 +58,31 @@ var (
 	}
 )
 
+// PlatformType is the console platform of the console endpoints
+type PlatformType string
+
+// All possible values of PlatformType
+const (
+	PlatformTypePlayStation PlatformType = "playstation"
+	PlatformTypeXbox        PlatformType = "xbox"
+)
+
+// PlatformTypes is a list of all available platform types
+var PlatformTypes = []PlatformType{
+	PlatformTypePlayStation,
+	PlatformTypeXbox,
+}
+
+// Valid returns whether the platform type is one of the known platform types
+func (p PlatformType) Valid() bool {
+	for _, platformType := range PlatformTypes {
+		if p == platformType {
+			return true
+		}
+	}
+	return false
+}
+
 // Locale string value for language
 type Locale string
 
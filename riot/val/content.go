@@ -1,7 +1,7 @@
 package val
 
 import (
-	"fmt"
+	"net/url"
 
 	log "github.com/sirupsen/logrus"
 
@@ -16,14 +16,13 @@ type ContentClient struct {
 // GetContent returns information about the in-game contents e.g. skins, maps, etc.
 func (cc *ContentClient) GetContent(locale Locale) (*ContentInfo, error) {
 	logger := cc.logger().WithField("method", "GetContent")
-	url := endPointGetContent
+	endpoint := endPointGetContent
 	if locale != "" {
-		url = fmt.Sprintf(endPointGetContent, locale)
+		endpoint += "?" + url.Values{"locale": {string(locale)}}.Encode()
 	}
 	var contents *ContentInfo
-	if err := cc.c.GetInto(url, &contents); err != nil {
+	if err := cc.c.GetInto(endpoint, &contents); err != nil {
 		logger.Debug(err)
-		fmt.Println(err)
 		return nil, err
 	}
 	return contents, nil
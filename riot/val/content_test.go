@@ -46,3 +46,20 @@ func TestChallengesClient_GetConfigWithChallengeId(t *testing.T) {
 		)
 	}
 }
+
+func TestContentClient_GetContentLocale(t *testing.T) {
+	t.Parallel()
+	var urls []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			urls = append(urls, r.URL.Path+"?"+r.URL.RawQuery)
+			return mock.NewJSONMockDoer(ContentInfo{}, 200).Do(r)
+		},
+	}
+	client := &ContentClient{c: internal.NewClient(RegionEurope, "API_KEY", doer, logrus.StandardLogger())}
+	_, err := client.GetContent(LocaleUnitedStates)
+	require.Nil(t, err)
+	_, err = client.GetContent("")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"/val/content/v1/contents?locale=en-US", "/val/content/v1/contents?"}, urls)
+}
@@ -1,6 +1,7 @@
 package val
 
 import (
+	"errors"
 	"fmt"
 
 	log "github.com/sirupsen/logrus"
@@ -8,6 +9,9 @@ import (
 	"github.com/KnutZuidema/golio/internal"
 )
 
+// ErrInvalidPlatformType is returned by the console endpoints if the platform type is not one of PlatformTypes
+var ErrInvalidPlatformType = errors.New("platform type must be one of PlatformTypes")
+
 // MatchClient provides methods for the match endpoints of the VALORANT API.
 type MatchClient struct {
 	c *internal.Client
@@ -16,11 +20,9 @@ type MatchClient struct {
 // GetMatchByID returns information about a match using match id
 func (cc *MatchClient) GetMatchByID(matchID string) (*Match, error) {
 	logger := cc.logger().WithField("method", "GetMatchByID")
-	url := endpointMatchByID
 	var match *Match
-	if err := cc.c.GetInto(fmt.Sprintf(url, matchID), &match); err != nil {
+	if err := cc.c.GetInto(fmt.Sprintf(endpointMatchByID, matchID), &match); err != nil {
 		logger.Debug(err)
-		fmt.Println(err)
 		return nil, err
 	}
 	return match, nil
@@ -29,11 +31,9 @@ func (cc *MatchClient) GetMatchByID(matchID string) (*Match, error) {
 // GetMatchListByPUUID returns match history as a list using player UUID
 func (cc *MatchClient) GetMatchListByPUUID(puuid string) (*MatchList, error) {
 	logger := cc.logger().WithField("method", "GetMatchListByPUUID")
-	url := endpointMatchListByPUUID
 	var matchList *MatchList
-	if err := cc.c.GetInto(fmt.Sprintf(url, puuid), &matchList); err != nil {
+	if err := cc.c.GetInto(fmt.Sprintf(endpointMatchListByPUUID, puuid), &matchList); err != nil {
 		logger.Debug(err)
-		fmt.Println(err)
 		return nil, err
 	}
 	return matchList, nil
@@ -42,11 +42,48 @@ func (cc *MatchClient) GetMatchListByPUUID(puuid string) (*MatchList, error) {
 // GetRecentMatchesByQueue returns last match IDs for live regions and e-sports routing
 func (cc *MatchClient) GetRecentMatchesByQueue(queue string) (*RecentMatches, error) {
 	logger := cc.logger().WithField("method", "GetRecentMatchesByQueue")
-	url := endpointRecentMatchesByQueue
 	var recentMatches *RecentMatches
-	if err := cc.c.GetInto(fmt.Sprintf(url, queue), &recentMatches); err != nil {
+	if err := cc.c.GetInto(fmt.Sprintf(endpointRecentMatchesByQueue, queue), &recentMatches); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return recentMatches, nil
+}
+
+// GetConsoleMatchByID returns information about a console match using match id
+func (cc *MatchClient) GetConsoleMatchByID(matchID string) (*Match, error) {
+	logger := cc.logger().WithField("method", "GetConsoleMatchByID")
+	var match *Match
+	if err := cc.c.GetInto(fmt.Sprintf(endpointConsoleMatchByID, matchID), &match); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return match, nil
+}
+
+// GetConsoleMatchListByPUUID returns the console match history of the given platform as a list using player UUID
+func (cc *MatchClient) GetConsoleMatchListByPUUID(puuid string, platformType PlatformType) (*MatchList, error) {
+	logger := cc.logger().WithField("method", "GetConsoleMatchListByPUUID")
+	if !platformType.Valid() {
+		logger.Debug(ErrInvalidPlatformType)
+		return nil, ErrInvalidPlatformType
+	}
+	var matchList *MatchList
+	if err := cc.c.GetInto(
+		fmt.Sprintf(endpointConsoleMatchListByPUUID, puuid, platformType), &matchList,
+	); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return matchList, nil
+}
+
+// GetConsoleRecentMatchesByQueue returns last console match IDs for live regions and e-sports routing
+func (cc *MatchClient) GetConsoleRecentMatchesByQueue(queue string) (*RecentMatches, error) {
+	logger := cc.logger().WithField("method", "GetConsoleRecentMatchesByQueue")
+	var recentMatches *RecentMatches
+	if err := cc.c.GetInto(fmt.Sprintf(endpointConsoleRecentMatchesByQueue, queue), &recentMatches); err != nil {
 		logger.Debug(err)
-		fmt.Println(err)
 		return nil, err
 	}
 	return recentMatches, nil
@@ -112,3 +112,41 @@ func TestChallengesClient_GetRecentMatchesByQueue(t *testing.T) {
 		)
 	}
 }
+
+func TestMatchClient_Console(t *testing.T) {
+	t.Parallel()
+	var paths []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			paths = append(paths, r.URL.Path+"?"+r.URL.RawQuery)
+			return mock.NewJSONMockDoer(struct{}{}, 200).Do(r)
+		},
+	}
+	client := &MatchClient{c: internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())}
+	match, err := client.GetConsoleMatchByID("match-id")
+	require.Nil(t, err)
+	assert.Equal(t, &Match{}, match)
+	matchList, err := client.GetConsoleMatchListByPUUID("puuid", PlatformTypePlayStation)
+	require.Nil(t, err)
+	assert.Equal(t, &MatchList{}, matchList)
+	recentMatches, err := client.GetConsoleRecentMatchesByQueue("console_competitive")
+	require.Nil(t, err)
+	assert.Equal(t, &RecentMatches{}, recentMatches)
+	assert.Equal(
+		t, []string{
+			"/val/match/console/v1/matches/match-id?",
+			"/val/match/console/v1/matchlists/by-puuid/puuid?platformType=playstation",
+			"/val/match/console/v1/recent-matches/by-queue/console_competitive?",
+		}, paths,
+	)
+	_, err = client.GetConsoleMatchListByPUUID("puuid", "")
+	assert.Equal(t, ErrInvalidPlatformType, err)
+}
+
+func TestPlatformType_Valid(t *testing.T) {
+	t.Parallel()
+	for _, platformType := range PlatformTypes {
+		assert.True(t, platformType.Valid())
+	}
+	assert.False(t, PlatformType("pc").Valid())
+}
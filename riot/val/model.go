@@ -1,5 +1,7 @@
 package val
 
+import "github.com/KnutZuidema/golio/api"
+
 // Act LocalizedNames is excluded because it is not sent when locale is set in request
 type Act struct {
 	Name     string `json:"name"`
@@ -115,6 +117,21 @@ type MatchInfo struct {
 	SeasonID           string `json:"seasonId"`
 }
 
+// Map returns the map the match was played on
+func (m *MatchInfo) Map(resolver *ContentResolver) (*ContentItem, error) {
+	return resolver.Map(m.MapID)
+}
+
+// Mode returns the game mode of the match
+func (m *MatchInfo) Mode(resolver *ContentResolver) (*ContentItem, error) {
+	return resolver.GameMode(m.GameMode)
+}
+
+// Act returns the act the match was played in
+func (m *MatchInfo) Act(resolver *ContentResolver) (*Act, error) {
+	return resolver.Act(m.SeasonID)
+}
+
 // MatchPlayer holds data of a player participating a match
 type MatchPlayer struct {
 	PuuID           string      `json:"puuid"`
@@ -129,6 +146,11 @@ type MatchPlayer struct {
 	PlayerTitle     string      `json:"playerTitle"`
 }
 
+// Agent returns the agent the player played
+func (p *MatchPlayer) Agent(resolver *ContentResolver) (*ContentItem, error) {
+	return resolver.Character(p.CharacterID)
+}
+
 // PlayerStats stats of a player in a match
 type PlayerStats struct {
 	Score          int          `json:"score"`
@@ -195,6 +217,21 @@ type RoundResult struct {
 	RoundResultCode       string             `json:"roundResultCode"`
 }
 
+// Site is a bomb site of a map
+type Site string
+
+// All possible values of Site
+const (
+	SiteA Site = "A"
+	SiteB Site = "B"
+	SiteC Site = "C"
+)
+
+// Site returns the site the bomb was planted on or an empty site if it was not planted in the round
+func (r *RoundResult) Site() Site {
+	return Site(r.PlantSite)
+}
+
 // PlayerRoundStats holds player stats by round
 type PlayerRoundStats struct {
 	PUUID   string   `json:"puuid"`
@@ -224,14 +261,23 @@ type FinishingDamage struct {
 	IsSecondaryFireMode bool   `json:"isSecondaryFireMode"`
 }
 
+// Item returns the weapon which dealt the finishing damage. api.ErrNotFound is returned if the damage was not dealt
+// by a weapon, e.g. by an ability or the bomb.
+func (d *FinishingDamage) Item(resolver *ContentResolver) (*ContentItem, error) {
+	if d.DamageType != "Weapon" {
+		return nil, api.ErrNotFound
+	}
+	return resolver.Equip(d.DamageItem)
+}
+
 // Damage contains information of a damage
 type Damage struct {
 	Receiver            string `json:"receiver"`
-	Damage              bool   `json:"damage"`
+	Damage              int    `json:"damage"`
 	IsSecondaryFireMode bool   `json:"isSecondaryFireMode"`
-	LegShots            bool   `json:"legshots"`
-	BodyShots           bool   `json:"bodyshots"`
-	Headshots           bool   `json:"headshots"`
+	LegShots            int    `json:"legshots"`
+	BodyShots           int    `json:"bodyshots"`
+	Headshots           int    `json:"headshots"`
 }
 
 // Economy holds economy information including spent credits
@@ -243,6 +289,16 @@ type Economy struct {
 	Spent        int    `json:"spent"`
 }
 
+// WeaponItem returns the weapon the player bought or kept in the round
+func (e *Economy) WeaponItem(resolver *ContentResolver) (*ContentItem, error) {
+	return resolver.Equip(e.Weapon)
+}
+
+// ArmorItem returns the armor the player bought or kept in the round
+func (e *Economy) ArmorItem(resolver *ContentResolver) (*ContentItem, error) {
+	return resolver.Equip(e.Armor)
+}
+
 // Ability holds ability effects of a player in a round
 type Ability struct {
 	GrenadeEffects  string `json:"grenadeEffects"`
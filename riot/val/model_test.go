@@ -0,0 +1,54 @@
+package val
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+func TestMatch_Resolve(t *testing.T) {
+	t.Parallel()
+	resolver := newTestResolver(new([]string))
+	info := &MatchInfo{
+		MapID:    "/Game/Maps/Ascent/Ascent",
+		GameMode: "/Game/GameModes/Bomb/BombGameMode.BombGameMode_C",
+		SeasonID: "4cb622e1-4244-6da3-7276-8daaf1c01be2",
+	}
+	gameMap, err := info.Map(resolver)
+	require.Nil(t, err)
+	assert.Equal(t, "Ascent", gameMap.Name)
+	mode, err := info.Mode(resolver)
+	require.Nil(t, err)
+	assert.Equal(t, "Standard", mode.Name)
+	act, err := info.Act(resolver)
+	require.Nil(t, err)
+	assert.Equal(t, "ACT I", act.Name)
+
+	agent, err := (&MatchPlayer{CharacterID: "add6443a-41bd-e414-f6ad-e58d267f4e95"}).Agent(resolver)
+	require.Nil(t, err)
+	assert.Equal(t, "Jett", agent.Name)
+
+	economy := &Economy{Weapon: "9c82e19d-4575-0200-1a81-3eacf00cf872", Armor: "822bcab2-40a2-324e-c137-e09195ad7692"}
+	weapon, err := economy.WeaponItem(resolver)
+	require.Nil(t, err)
+	assert.Equal(t, "Vandal", weapon.Name)
+	armor, err := economy.ArmorItem(resolver)
+	require.Nil(t, err)
+	assert.Equal(t, "Heavy Shields", armor.Name)
+
+	damage := &FinishingDamage{DamageType: "Weapon", DamageItem: "9C82E19D-4575-0200-1A81-3EACF00CF872"}
+	weapon, err = damage.Item(resolver)
+	require.Nil(t, err)
+	assert.Equal(t, "Vandal", weapon.Name)
+	_, err = (&FinishingDamage{DamageType: "Ability", DamageItem: "Ultimate"}).Item(resolver)
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestRoundResult_Site(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, SiteB, (&RoundResult{PlantSite: "B"}).Site())
+	assert.Equal(t, Site(""), (&RoundResult{}).Site())
+}
@@ -6,8 +6,11 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/pagination"
 )
 
+const maxLeaderboardSize = 200
+
 // RankedClient provides methods for the ranked endpoints of the VALORANT API.
 type RankedClient struct {
 	c *internal.Client
@@ -21,18 +24,80 @@ func (cc *RankedClient) GetLeaderboardByActID(actID string, startIndex, size int
 		startIndex = 0
 	}
 	if size < 1 {
-		size = 200
+		size = maxLeaderboardSize
 	}
 	if err := cc.c.GetInto(
 		fmt.Sprintf(endpointGetLeaderboardByActID+"?size=%d&startIndex=%d", actID, size, startIndex), &leaderboard,
 	); err != nil {
 		logger.Debug(err)
-		fmt.Println(err)
 		return nil, err
 	}
 	return leaderboard, nil
 }
 
+// IterateLeaderboardByActID returns an iterator over the players of the leaderboard for the competitive queue by
+// act ID, requesting up to 200 players per page
+func (cc *RankedClient) IterateLeaderboardByActID(
+	actID string, options *pagination.Options,
+) *pagination.Iterator[*Player] {
+	return pagination.New(
+		func(page pagination.Page) ([]*Player, error) {
+			leaderboard, err := cc.GetLeaderboardByActID(actID, int32(page.Start), int32(page.Count))
+			if err != nil {
+				return nil, err
+			}
+			return leaderboard.Players, nil
+		}, maxLeaderboardSize, options,
+	)
+}
+
+// GetConsoleLeaderboardByActID returns the console leaderboard of the given platform for the competitive queue by
+// act ID
+func (cc *RankedClient) GetConsoleLeaderboardByActID(
+	actID string, platformType PlatformType, startIndex, size int32,
+) (*Leaderboard, error) {
+	logger := cc.logger().WithField("method", "GetConsoleLeaderboardByActID")
+	if !platformType.Valid() {
+		logger.Debug(ErrInvalidPlatformType)
+		return nil, ErrInvalidPlatformType
+	}
+	var leaderboard *Leaderboard
+	if startIndex < 0 {
+		startIndex = 0
+	}
+	if size < 1 {
+		size = maxLeaderboardSize
+	}
+	if err := cc.c.GetInto(
+		fmt.Sprintf(
+			endpointGetConsoleLeaderboardByActID+"?platformType=%s&size=%d&startIndex=%d",
+			actID, platformType, size, startIndex,
+		), &leaderboard,
+	); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return leaderboard, nil
+}
+
+// IterateConsoleLeaderboardByActID returns an iterator over the players of the console leaderboard of the given
+// platform for the competitive queue by act ID, requesting up to 200 players per page
+func (cc *RankedClient) IterateConsoleLeaderboardByActID(
+	actID string, platformType PlatformType, options *pagination.Options,
+) *pagination.Iterator[*Player] {
+	return pagination.New(
+		func(page pagination.Page) ([]*Player, error) {
+			leaderboard, err := cc.GetConsoleLeaderboardByActID(
+				actID, platformType, int32(page.Start), int32(page.Count),
+			)
+			if err != nil {
+				return nil, err
+			}
+			return leaderboard.Players, nil
+		}, maxLeaderboardSize, options,
+	)
+}
+
 func (cc *RankedClient) logger() log.FieldLogger {
 	return cc.c.Logger().WithField("category", "ranked")
 }
@@ -3,6 +3,7 @@ package val
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/sirupsen/logrus"
@@ -12,6 +13,7 @@ import (
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/pagination"
 )
 
 func TestChallengesClient_GetLeaderboardByActId(t *testing.T) {
@@ -46,3 +48,60 @@ func TestChallengesClient_GetLeaderboardByActId(t *testing.T) {
 		)
 	}
 }
+
+func TestRankedClient_IterateLeaderboardByActID(t *testing.T) {
+	t.Parallel()
+	var requests []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requests = append(requests, r.URL.RawQuery)
+			start, _ := strconv.Atoi(r.URL.Query().Get("startIndex"))
+			size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+			leaderboard := Leaderboard{}
+			for i := start; i < 250 && i < start+size; i++ {
+				leaderboard.Players = append(leaderboard.Players, &Player{LeaderboardRank: int64(i + 1)})
+			}
+			return mock.NewJSONMockDoer(leaderboard, 200).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	got, err := (&RankedClient{c: client}).IterateLeaderboardByActID("actId", nil).Collect()
+	require.Nil(t, err)
+	require.Len(t, got, 250)
+	assert.Equal(t, int64(250), got[249].LeaderboardRank)
+	assert.Equal(t, []string{"size=200&startIndex=0", "size=200&startIndex=200"}, requests)
+
+	_, err = (&RankedClient{c: client}).IterateLeaderboardByActID(
+		"actId", &pagination.Options{MaxItems: 10},
+	).Collect()
+	require.Nil(t, err)
+	assert.Equal(t, "size=10&startIndex=0", requests[2])
+}
+
+func TestRankedClient_GetConsoleLeaderboardByActID(t *testing.T) {
+	t.Parallel()
+	var requests []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requests = append(requests, r.URL.Path+"?"+r.URL.RawQuery)
+			return mock.NewJSONMockDoer(Leaderboard{}, 200).Do(r)
+		},
+	}
+	client := &RankedClient{c: internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())}
+	got, err := client.GetConsoleLeaderboardByActID("actId", PlatformTypeXbox, 10, 0)
+	require.Nil(t, err)
+	assert.Equal(t, &Leaderboard{}, got)
+	_, err = client.IterateConsoleLeaderboardByActID(
+		"actId", PlatformTypePlayStation, &pagination.Options{MaxItems: 10},
+	).Collect()
+	require.Nil(t, err)
+	assert.Equal(
+		t, []string{
+			"/val/console/ranked/v1/leaderboards/by-act/actId?platformType=xbox&size=200&startIndex=10",
+			"/val/console/ranked/v1/leaderboards/by-act/actId?platformType=playstation&size=10&startIndex=0",
+		}, requests,
+	)
+	_, err = client.GetConsoleLeaderboardByActID("actId", "switch", 0, 0)
+	assert.Equal(t, ErrInvalidPlatformType, err)
+	assert.Len(t, requests, 2)
+}
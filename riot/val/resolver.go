@@ -0,0 +1,144 @@
+package val
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// ContentResolver resolves the IDs and asset paths used in matches to the content returned by
+// ContentClient.GetContent. The content is requested once per locale and cached.
+type ContentResolver struct {
+	client *ContentClient
+	locale Locale
+	cache  *contentCache
+}
+
+type contentCache struct {
+	mu      sync.Mutex
+	indexes map[Locale]*contentIndex
+}
+
+// contentIndex contains the content of a locale by lower case ID and asset path
+type contentIndex struct {
+	characters map[string]*ContentItem
+	maps       map[string]*ContentItem
+	equips     map[string]*ContentItem
+	gameModes  map[string]*ContentItem
+	acts       map[string]*Act
+}
+
+// NewContentResolver returns a new ContentResolver resolving content in the given locale. If the locale is empty
+// the names are returned in the default locale of the API.
+func NewContentResolver(client *ContentClient, locale Locale) *ContentResolver {
+	return &ContentResolver{
+		client: client,
+		locale: locale,
+		cache:  &contentCache{indexes: map[Locale]*contentIndex{}},
+	}
+}
+
+// WithLocale returns a ContentResolver resolving content in the given locale which shares the cache with r
+func (r *ContentResolver) WithLocale(locale Locale) *ContentResolver {
+	return &ContentResolver{
+		client: r.client,
+		locale: locale,
+		cache:  r.cache,
+	}
+}
+
+// Character returns the agent with the given character ID
+func (r *ContentResolver) Character(id string) (*ContentItem, error) {
+	return resolve(r, id, func(index *contentIndex) map[string]*ContentItem { return index.characters })
+}
+
+// Map returns the map with the given ID or asset path. Matches identify their map by its asset path.
+func (r *ContentResolver) Map(id string) (*ContentItem, error) {
+	return resolve(r, id, func(index *contentIndex) map[string]*ContentItem { return index.maps })
+}
+
+// Equip returns the weapon or armor with the given ID
+func (r *ContentResolver) Equip(id string) (*ContentItem, error) {
+	return resolve(r, id, func(index *contentIndex) map[string]*ContentItem { return index.equips })
+}
+
+// GameMode returns the game mode with the given ID or asset path. Matches identify their game mode by the asset
+// path of its class, e.g. /Game/GameModes/Bomb/BombGameMode.BombGameMode_C.
+func (r *ContentResolver) GameMode(id string) (*ContentItem, error) {
+	item, err := resolve(r, id, func(index *contentIndex) map[string]*ContentItem { return index.gameModes })
+	if errors.Is(err, api.ErrNotFound) && strings.Contains(id, ".") {
+		return r.GameMode(id[:strings.LastIndex(id, ".")])
+	}
+	return item, err
+}
+
+// Act returns the act with the given ID. Matches identify the act they were played in by their season ID.
+func (r *ContentResolver) Act(id string) (*Act, error) {
+	return resolve(r, id, func(index *contentIndex) map[string]*Act { return index.acts })
+}
+
+// Clear removes the cached content of all locales, so it is requested again on the next lookup
+func (r *ContentResolver) Clear() {
+	r.cache.mu.Lock()
+	defer r.cache.mu.Unlock()
+	r.cache.indexes = map[Locale]*contentIndex{}
+}
+
+func (r *ContentResolver) index() (*contentIndex, error) {
+	r.cache.mu.Lock()
+	defer r.cache.mu.Unlock()
+	if index, ok := r.cache.indexes[r.locale]; ok {
+		return index, nil
+	}
+	content, err := r.client.GetContent(r.locale)
+	if err != nil {
+		return nil, err
+	}
+	index := newContentIndex(content)
+	r.cache.indexes[r.locale] = index
+	return index, nil
+}
+
+func resolve[T any](r *ContentResolver, id string, items func(*contentIndex) map[string]*T) (*T, error) {
+	index, err := r.index()
+	if err != nil {
+		return nil, err
+	}
+	item, ok := items(index)[strings.ToLower(id)]
+	if !ok {
+		return nil, api.ErrNotFound
+	}
+	return item, nil
+}
+
+func newContentIndex(content *ContentInfo) *contentIndex {
+	index := &contentIndex{
+		characters: map[string]*ContentItem{},
+		maps:       map[string]*ContentItem{},
+		equips:     map[string]*ContentItem{},
+		gameModes:  map[string]*ContentItem{},
+		acts:       map[string]*Act{},
+	}
+	if content == nil {
+		return index
+	}
+	addContentItems(index.characters, content.Characters)
+	addContentItems(index.maps, content.Maps)
+	addContentItems(index.equips, content.Equips)
+	addContentItems(index.gameModes, content.GameModes)
+	for _, act := range content.Acts {
+		index.acts[strings.ToLower(act.ID)] = act
+	}
+	return index
+}
+
+func addContentItems(index map[string]*ContentItem, items []*ContentItem) {
+	for _, item := range items {
+		index[strings.ToLower(item.ID)] = item
+		if item.AssetPath != "" {
+			index[strings.ToLower(item.AssetPath)] = item
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package val
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+var testContent = ContentInfo{
+	Characters: []*ContentItem{
+		{Name: "Jett", ID: "ADD6443A-41BD-E414-F6AD-E58D267F4E95", AssetName: "Wushu"},
+	},
+	Maps: []*ContentItem{
+		{Name: "Ascent", ID: "7EAECC1B-4337-BBF6-6AB9-04B8F06B3319", AssetPath: "/Game/Maps/Ascent/Ascent"},
+	},
+	Equips: []*ContentItem{
+		{Name: "Vandal", ID: "9C82E19D-4575-0200-1A81-3EACF00CF872"},
+		{Name: "Heavy Shields", ID: "822BCAB2-40A2-324E-C137-E09195AD7692"},
+	},
+	GameModes: []*ContentItem{
+		{Name: "Standard", ID: "96BD3920-4F36-D026-2B28-C683EB0BCAC5", AssetPath: "/Game/GameModes/Bomb/BombGameMode"},
+	},
+	Acts: []*Act{
+		{Name: "ACT I", ID: "4CB622E1-4244-6DA3-7276-8DAAF1C01BE2", IsActive: true},
+	},
+}
+
+// newTestResolver returns a resolver whose content requests are recorded in locales
+func newTestResolver(locales *[]string) *ContentResolver {
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			*locales = append(*locales, r.URL.Query().Get("locale"))
+			return mock.NewJSONMockDoer(testContent, 200).Do(r)
+		},
+	}
+	client := &ContentClient{c: internal.NewClient(RegionEurope, "API_KEY", doer, logrus.StandardLogger())}
+	return NewContentResolver(client, LocaleUnitedStates)
+}
+
+func TestContentResolver(t *testing.T) {
+	t.Parallel()
+	var locales []string
+	resolver := newTestResolver(&locales)
+	character, err := resolver.Character("add6443a-41bd-e414-f6ad-e58d267f4e95")
+	require.Nil(t, err)
+	assert.Equal(t, "Jett", character.Name)
+	gameMap, err := resolver.Map("/Game/Maps/Ascent/Ascent")
+	require.Nil(t, err)
+	assert.Equal(t, "Ascent", gameMap.Name)
+	gameMap, err = resolver.Map("7eaecc1b-4337-bbf6-6ab9-04b8f06b3319")
+	require.Nil(t, err)
+	assert.Equal(t, "Ascent", gameMap.Name)
+	equip, err := resolver.Equip("9c82e19d-4575-0200-1a81-3eacf00cf872")
+	require.Nil(t, err)
+	assert.Equal(t, "Vandal", equip.Name)
+	gameMode, err := resolver.GameMode("/Game/GameModes/Bomb/BombGameMode.BombGameMode_C")
+	require.Nil(t, err)
+	assert.Equal(t, "Standard", gameMode.Name)
+	act, err := resolver.Act("4cb622e1-4244-6da3-7276-8daaf1c01be2")
+	require.Nil(t, err)
+	assert.Equal(t, "ACT I", act.Name)
+	_, err = resolver.Character("unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = resolver.GameMode("/Game/GameModes/Unknown.Unknown_C")
+	assert.Equal(t, api.ErrNotFound, err)
+	assert.Equal(t, []string{"en-US"}, locales, "content should be requested once")
+
+	_, err = resolver.WithLocale(LocaleGermany).Character("add6443a-41bd-e414-f6ad-e58d267f4e95")
+	require.Nil(t, err)
+	_, err = resolver.WithLocale(LocaleGermany).Map("/Game/Maps/Ascent/Ascent")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"en-US", "de-DE"}, locales, "content should be cached per locale")
+
+	resolver.Clear()
+	_, err = resolver.Act("4cb622e1-4244-6da3-7276-8daaf1c01be2")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"en-US", "de-DE", "en-US"}, locales)
+}
+
+func TestContentResolver_Error(t *testing.T) {
+	t.Parallel()
+	client := &ContentClient{
+		c: internal.NewClient(
+			RegionEurope, "API_KEY", mock.NewStatusMockDoer(http.StatusForbidden), logrus.StandardLogger(),
+		),
+	}
+	_, err := NewContentResolver(client, "").Character("id")
+	assert.Equal(t, api.ErrForbidden, err)
+}